@@ -1,29 +1,96 @@
 package cmd
 
 import (
+	"fmt"
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea/v2"
 	"github.com/charmbracelet/log"
 	"github.com/spf13/cobra"
 
+	"go.dalton.dog/batterup/internal/config"
 	"go.dalton.dog/batterup/internal/mlb"
 	"go.dalton.dog/batterup/internal/ui"
 )
 
+var (
+	dateFlag     string
+	timezoneFlag string
+	rolloverFlag int
+	teamFlag     string
+)
+
 var rootCmd = cobra.Command{
 	Use:   "batterup",
 	Short: "Monitor MLB games in your terminal",
-	Run: func(cmd *cobra.Command, args []string) {
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.Load()
+		if err != nil {
+			return err
+		}
+		if cmd.Flags().Changed("timezone") {
+			cfg.Timezone = timezoneFlag
+		}
+		if cmd.Flags().Changed("rollover") {
+			cfg.DayRolloverHour = rolloverFlag
+		}
+		if cmd.Flags().Changed("team") {
+			cfg.Team = teamFlag
+		}
+		if err := cfg.Validate(); err != nil {
+			return err
+		}
+		location, err := cfg.Location()
+		if err != nil {
+			return err
+		}
+
+		opts := ui.Options{
+			Location:        location,
+			DayRolloverHour: cfg.DayRolloverHour,
+			Team:            cfg.Team,
+			Schedule:        cfg.Schedule,
+			SaveSchedule:    saveSchedulePreferences,
+		}
+		if dateFlag != "" {
+			date, err := time.ParseInLocation("2006-01-02", dateFlag, location)
+			if err != nil {
+				return fmt.Errorf("invalid --date %q, expected YYYY-MM-DD", dateFlag)
+			}
+			opts.Date = date
+		}
+
 		client := mlb.NewClient()
-		model := ui.NewAppModel(client)
+		model := ui.NewAppModel(client, opts)
 
 		program := tea.NewProgram(model, tea.WithAltScreen())
 
 		if _, err := program.Run(); err != nil {
 			log.Fatalf("Error running BatterUp: %v", err)
 		}
+		return nil
 	},
 }
 
+// saveSchedulePreferences rereads the config file before writing so that
+// one-off flag overrides are never persisted.
+func saveSchedulePreferences(prefs config.Schedule) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	cfg.Schedule = prefs
+	return config.Save(cfg)
+}
+
+func init() {
+	rootCmd.Flags().StringVar(&dateFlag, "date", "", "schedule date to open, as YYYY-MM-DD (defaults to today)")
+	rootCmd.Flags().StringVar(&timezoneFlag, "timezone", "", "IANA timezone for displayed times, e.g. America/Chicago (defaults to the system zone)")
+	rootCmd.Flags().StringVar(&teamFlag, "team", "", "abbreviation of the team you follow, e.g. SEA, for local broadcast listings")
+	rootCmd.Flags().IntVar(&rolloverFlag, "rollover", 0, "hour of the day, 0-23, when the schedule's today advances")
+}
+
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		log.Fatal(err)
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"go.dalton.dog/batterup/internal/mlb"
+	"go.dalton.dog/batterup/internal/ui"
+)
+
+var verifyCmd = cobra.Command{
+	Use:          "verify <gamePk>...",
+	Short:        "Compare the play-by-play line score against the official feed",
+	Args:         cobra.MinimumNArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client := mlb.NewClient()
+		failed := 0
+
+		for _, arg := range args {
+			gameID, err := strconv.Atoi(arg)
+			if err != nil {
+				return fmt.Errorf("invalid game ID %q", arg)
+			}
+
+			feed, err := client.FetchGame(context.Background(), gameID)
+			if err != nil {
+				return err
+			}
+
+			mismatches := ui.VerifyLineScore(feed)
+			if len(mismatches) == 0 {
+				cmd.Printf("%d: line score matches\n", gameID)
+				continue
+			}
+
+			failed++
+			cmd.Printf("%d: %d mismatch(es)\n", gameID, len(mismatches))
+			for _, mismatch := range mismatches {
+				cmd.Printf("  %s\n", mismatch)
+			}
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("%d game(s) did not match the official line score", failed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(&verifyCmd)
+}
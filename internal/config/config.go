@@ -0,0 +1,124 @@
+// Package config loads the user's batterup preferences from disk.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Config holds user preferences. The zero value is a valid default.
+type Config struct {
+	// Timezone is an IANA zone name, such as "America/Los_Angeles", used for
+	// every time shown in the TUI. Empty means the system's local zone.
+	Timezone string `json:"timezone,omitempty"`
+
+	// DayRolloverHour is the hour, 0-23, at which "today" advances to the next
+	// baseball day. Late games stay on the previous day until then.
+	DayRolloverHour int `json:"dayRolloverHour,omitempty"`
+
+	// Team is the abbreviation of the club you follow, such as "SEA". In its
+	// games only its local broadcasts are listed alongside national ones.
+	Team string `json:"team,omitempty"`
+
+	// Schedule remembers how the schedule grid was last sorted and filtered.
+	Schedule Schedule `json:"schedule"`
+}
+
+// Schedule holds the schedule view's sort and filter modes by name. Unknown
+// or empty names fall back to the view's defaults.
+type Schedule struct {
+	Sort   string `json:"sort,omitempty"`
+	Filter string `json:"filter,omitempty"`
+}
+
+// Path returns the location of the config file inside the user's config directory.
+func Path() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locate config directory: %w", err)
+	}
+	return filepath.Join(dir, "batterup", "config.json"), nil
+}
+
+// Load reads the config file from its default path. A missing file is not
+// an error and yields the default config.
+func Load() (Config, error) {
+	path, err := Path()
+	if err != nil {
+		return Config{}, err
+	}
+	return LoadFile(path)
+}
+
+// LoadFile reads and validates the config file at path. A missing file
+// yields the default config.
+func LoadFile(path string) (Config, error) {
+	var cfg Config
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("read config: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return cfg, fmt.Errorf("config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Save writes the config to its default path, creating the directory if needed.
+func Save(cfg Config) error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	return SaveFile(path, cfg)
+}
+
+// SaveFile writes the config as indented JSON to path.
+func SaveFile(path string, cfg Config) error {
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode config: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+	return nil
+}
+
+// Validate reports settings that cannot be applied.
+func (c Config) Validate() error {
+	if c.DayRolloverHour < 0 || c.DayRolloverHour > 23 {
+		return fmt.Errorf("dayRolloverHour must be between 0 and 23, got %d", c.DayRolloverHour)
+	}
+	if _, err := c.Location(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Location resolves Timezone, falling back to the system zone when unset.
+func (c Config) Location() (*time.Location, error) {
+	if c.Timezone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", c.Timezone)
+	}
+	return loc, nil
+}
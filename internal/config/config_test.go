@@ -0,0 +1,75 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	return path
+}
+
+func TestLoadFileMissingUsesDefaults(t *testing.T) {
+	cfg, err := LoadFile(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("expected no error for a missing file, got %v", err)
+	}
+	loc, err := cfg.Location()
+	if err != nil || loc != time.Local {
+		t.Fatalf("expected the local zone by default, got %v (%v)", loc, err)
+	}
+}
+
+func TestLoadFileReadsSettings(t *testing.T) {
+	path := writeConfig(t, `{"timezone": "America/Los_Angeles", "dayRolloverHour": 6}`)
+	cfg, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile returned error: %v", err)
+	}
+	if cfg.DayRolloverHour != 6 {
+		t.Fatalf("expected rollover hour 6, got %d", cfg.DayRolloverHour)
+	}
+	loc, err := cfg.Location()
+	if err != nil || loc.String() != "America/Los_Angeles" {
+		t.Fatalf("expected Los Angeles zone, got %v (%v)", loc, err)
+	}
+}
+
+func TestLoadFileRejectsInvalidSettings(t *testing.T) {
+	tests := map[string]string{
+		"bad timezone": `{"timezone": "Mars/Olympus_Mons"}`,
+		"bad rollover": `{"dayRolloverHour": 24}`,
+		"bad json":     `{"timezone": `,
+	}
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadFile(writeConfig(t, body))
+			if err == nil || !strings.Contains(err.Error(), "config") {
+				t.Fatalf("expected a config error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSaveFileRoundTrips(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "config.json")
+	want := Config{Team: "SEA", Schedule: Schedule{Sort: "live", Filter: "al-west"}}
+	if err := SaveFile(path, want); err != nil {
+		t.Fatalf("SaveFile returned error: %v", err)
+	}
+	got, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile returned error: %v", err)
+	}
+	if got != want {
+		t.Fatalf("expected %+v after round trip, got %+v", want, got)
+	}
+}
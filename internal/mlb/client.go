@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -13,6 +14,7 @@ const (
 	userAgent        = "go.dalton.dog/batterup/1.0"
 	scheduleEndpoint = "https://statsapi.mlb.com/api/v1/schedule"
 	gameEndpointFmt  = "https://statsapi.mlb.com/api/v1.1/game/%d/feed/live"
+	statsEndpointFmt = "https://statsapi.mlb.com/api/v1/people/%d/stats"
 )
 
 func (c *Client) get(ctx context.Context, endpoint string, out any) error {
@@ -49,31 +51,115 @@ func NewClient() *Client {
 	return &Client{http: &http.Client{Timeout: 15 * time.Second}}
 }
 
-// FetchSchedule retrieves the MLB schedule for a specific day.
-func (c *Client) FetchSchedule(ctx context.Context, date time.Time) (*ScheduleResponse, error) {
+// NewClientWithHTTP returns a Client that sends its requests through
+// httpClient, for callers that need their own transport or timeout.
+func NewClientWithHTTP(httpClient *http.Client) *Client {
+	return &Client{http: httpClient}
+}
+
+// ScheduleQuery selects which games FetchSchedule returns. Set Date for a
+// single day, StartDate and EndDate for an inclusive range, or Season for a
+// whole season. TeamID narrows the results to one club when non-zero.
+// SportID defaults to SportMLB, GameTypes to every game type, and Hydrate to
+// teams and line scores.
+type ScheduleQuery struct {
+	Date      time.Time
+	StartDate time.Time
+	EndDate   time.Time
+	Season    int
+	TeamID    int
+	SportID   int
+	GameTypes []string
+	Hydrate   []string
+}
+
+// DayScheduleHydrate is everything the day's schedule shows beside the score:
+// broadcasts, probable pitchers with their season stats, and pitching decisions.
+var DayScheduleHydrate = []string{"team", "linescore", "broadcasts(all)", "probablePitcher(stats(type=season,group=pitching))", "decisions"}
+
+func (q ScheduleQuery) values() url.Values {
+	sportID := q.SportID
+	if sportID == 0 {
+		sportID = SportMLB
+	}
+	hydrate := q.Hydrate
+	if len(hydrate) == 0 {
+		hydrate = []string{"team", "linescore"}
+	}
+
 	queryVals := url.Values{}
-	queryVals.Set("sportId", "1")
-	queryVals.Set("hydrate", "team,linescore")
-	queryVals.Set("date", date.Format("01/02/2006"))
+	queryVals.Set("sportId", fmt.Sprintf("%d", sportID))
+	queryVals.Set("hydrate", strings.Join(hydrate, ","))
+	if !q.Date.IsZero() {
+		queryVals.Set("date", q.Date.Format("01/02/2006"))
+	}
+	if !q.StartDate.IsZero() {
+		queryVals.Set("startDate", q.StartDate.Format("01/02/2006"))
+	}
+	if !q.EndDate.IsZero() {
+		queryVals.Set("endDate", q.EndDate.Format("01/02/2006"))
+	}
+	if q.Season != 0 {
+		queryVals.Set("season", fmt.Sprintf("%d", q.Season))
+	}
+	if q.TeamID != 0 {
+		queryVals.Set("teamId", fmt.Sprintf("%d", q.TeamID))
+	}
+	if len(q.GameTypes) > 0 {
+		queryVals.Set("gameType", strings.Join(q.GameTypes, ","))
+	}
+	return queryVals
+}
 
-	endpoint := fmt.Sprintf("%s?%s", scheduleEndpoint, queryVals.Encode())
+// FetchSchedule retrieves the MLB schedule matching the query.
+func (c *Client) FetchSchedule(ctx context.Context, query ScheduleQuery) (*ScheduleResponse, error) {
+	endpoint := fmt.Sprintf("%s?%s", scheduleEndpoint, query.values().Encode())
 
 	var resp ScheduleResponse
 	if err := c.get(ctx, endpoint, &resp); err != nil {
 		return nil, fmt.Errorf("schedule request failed: %w", err)
 	}
 
-	for dateIdx := range resp.Dates {
-		for gameIdx := range resp.Dates[dateIdx].Games {
-			game := &resp.Dates[dateIdx].Games[gameIdx]
-			parsed, err := time.Parse(time.RFC3339, game.GameDateRaw)
-			if err == nil {
-				game.GameDate = parsed
-			}
+	resp.parseGameDates()
+	return &resp, nil
+}
+
+// FetchPostseason retrieves every postseason game a level played in a season,
+// from the wild card round through the World Series, grouped into series.
+func (c *Client) FetchPostseason(ctx context.Context, season, sportID int) ([]PostseasonSeries, error) {
+	resp, err := c.FetchSchedule(ctx, ScheduleQuery{
+		Season:    season,
+		SportID:   sportID,
+		GameTypes: PostseasonRounds,
+		Hydrate:   []string{"team", "linescore", "broadcasts(all)", "seriesStatus"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groupPostseasonSeries(resp), nil
+}
+
+// parseGameDates fills the parsed time fields from the raw timestamps on every game.
+func (r *ScheduleResponse) parseGameDates() {
+	for dateIdx := range r.Dates {
+		for gameIdx := range r.Dates[dateIdx].Games {
+			game := &r.Dates[dateIdx].Games[gameIdx]
+			game.GameDate = parseScheduleTime(game.GameDateRaw)
+			game.ResumeDate = parseScheduleTime(game.ResumeDateRaw)
+			game.ResumedFrom = parseScheduleTime(game.ResumedFromRaw)
+			game.RescheduleDate = parseScheduleTime(game.RescheduleDateRaw)
 		}
 	}
+}
 
-	return &resp, nil
+// parseScheduleTime parses an RFC 3339 timestamp, returning the zero time
+// when the field is absent or malformed.
+func parseScheduleTime(raw string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
 }
 
 // FetchGame returns the live feed for a specific MLB game.
@@ -85,3 +171,24 @@ func (c *Client) FetchGame(ctx context.Context, gameID int) (*GameFeed, error) {
 	}
 	return &feed, nil
 }
+
+// FetchPitchingGameLog returns a pitcher's game-by-game pitching lines for a season, oldest first.
+func (c *Client) FetchPitchingGameLog(ctx context.Context, personID, season int) ([]GameLogSplit, error) {
+	queryVals := url.Values{}
+	queryVals.Set("stats", "gameLog")
+	queryVals.Set("group", "pitching")
+	queryVals.Set("season", fmt.Sprintf("%d", season))
+
+	endpoint := fmt.Sprintf("%s?%s", fmt.Sprintf(statsEndpointFmt, personID), queryVals.Encode())
+
+	var resp StatsResponse
+	if err := c.get(ctx, endpoint, &resp); err != nil {
+		return nil, fmt.Errorf("game log request failed: %w", err)
+	}
+
+	var splits []GameLogSplit
+	for _, group := range resp.Stats {
+		splits = append(splits, group.Splits...)
+	}
+	return splits, nil
+}
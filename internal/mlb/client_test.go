@@ -43,6 +43,12 @@ func TestClientFetchScheduleSuccess(t *testing.T) {
 		if req.URL.Host != "statsapi.mlb.com" {
 			t.Fatalf("unexpected host %s", req.URL.Host)
 		}
+		if got := req.URL.Query().Get("sportId"); got != "1" {
+			t.Fatalf("expected default sportId 1, got %q", got)
+		}
+		if got := req.URL.Query().Get("hydrate"); !strings.Contains(got, "broadcasts(all)") || !strings.Contains(got, "probablePitcher") || !strings.Contains(got, "decisions") {
+			t.Fatalf("expected broadcast, probable pitcher and decision hydration, got %q", got)
+		}
 		if got := req.URL.Query().Get("date"); got != date.Format("01/02/2006") {
 			t.Fatalf("expected date query %q, got %q", date.Format("01/02/2006"), got)
 		}
@@ -56,6 +62,7 @@ func TestClientFetchScheduleSuccess(t *testing.T) {
                             "gameDate": "2024-04-01T19:05:00Z",
                             "doubleHeader": "N",
                             "gameNumber": 1,
+                            "resumeDate": "2024-04-02T17:05:00Z",
                             "status": {"abstractGameCode": "P", "detailedState": "Scheduled"},
                             "teams": {
                                 "away": {
@@ -66,7 +73,12 @@ func TestClientFetchScheduleSuccess(t *testing.T) {
                                 "home": {
                                     "team": {"teamName": "Home", "abbreviation": "HME"},
                                     "leagueRecord": {"wins": 8, "losses": 7},
-                                    "isWinner": false
+                                    "isWinner": false,
+                                    "probablePitcher": {
+                                        "id": 543037,
+                                        "fullName": "Gerrit Cole",
+                                        "stats": [{"splits": [{"stat": {"wins": 12, "losses": 4, "era": "3.01"}}]}]
+                                    }
                                 }
                             }
                         }
@@ -79,7 +91,7 @@ func TestClientFetchScheduleSuccess(t *testing.T) {
 
 	client := &Client{http: &http.Client{Transport: rt}}
 
-	resp, err := client.FetchSchedule(context.Background(), date)
+	resp, err := client.FetchSchedule(context.Background(), ScheduleQuery{Date: date, Hydrate: DayScheduleHydrate})
 	if err != nil {
 		t.Fatalf("FetchSchedule returned error: %v", err)
 	}
@@ -100,6 +112,21 @@ func TestClientFetchScheduleSuccess(t *testing.T) {
 	if !games[0].GameDate.Equal(want) {
 		t.Fatalf("expected GameDate %v, got %v", want, games[0].GameDate)
 	}
+	if resume := time.Date(2024, time.April, 2, 17, 5, 0, 0, time.UTC); !games[0].ResumeDate.Equal(resume) {
+		t.Fatalf("expected ResumeDate %v, got %v", resume, games[0].ResumeDate)
+	}
+	if !games[0].ResumedFrom.IsZero() {
+		t.Fatalf("expected missing ResumedFrom to stay zero")
+	}
+	if probable := games[0].Teams.Home.ProbablePitcher; probable == nil || probable.FullName != "Gerrit Cole" {
+		t.Fatalf("expected home probable pitcher to be decoded, got %+v", probable)
+	}
+	if season, ok := games[0].Teams.Home.ProbablePitcher.Season(); !ok || season.Wins != 12 || season.ERA != "3.01" {
+		t.Fatalf("expected probable pitcher season stats, got %+v", season)
+	}
+	if _, ok := (SchedulePitcher{}).Season(); ok {
+		t.Fatalf("expected no season line without hydrated stats")
+	}
 }
 
 func TestClientFetchScheduleErrorStatus(t *testing.T) {
@@ -108,7 +135,7 @@ func TestClientFetchScheduleErrorStatus(t *testing.T) {
 	})
 	client := &Client{http: &http.Client{Transport: rt}}
 
-	_, err := client.FetchSchedule(context.Background(), time.Now())
+	_, err := client.FetchSchedule(context.Background(), ScheduleQuery{Date: time.Now()})
 	if err == nil {
 		t.Fatalf("expected error, got nil")
 	}
@@ -117,6 +144,123 @@ func TestClientFetchScheduleErrorStatus(t *testing.T) {
 	}
 }
 
+func TestClientFetchScheduleRangeForTeam(t *testing.T) {
+	start := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC)
+
+	rt := roundTripFunc(func(req *http.Request) *http.Response {
+		query := req.URL.Query()
+		if query.Has("date") {
+			t.Fatalf("expected no single date for a range query, got %q", query.Get("date"))
+		}
+		if got := query.Get("startDate"); got != "06/01/2024" {
+			t.Fatalf("expected startDate 06/01/2024, got %q", got)
+		}
+		if got := query.Get("endDate"); got != "06/30/2024" {
+			t.Fatalf("expected endDate 06/30/2024, got %q", got)
+		}
+		if got := query.Get("sportId"); got != "11" {
+			t.Fatalf("expected sportId 11, got %q", got)
+		}
+		if got := query.Get("gameType"); got != "S,R" {
+			t.Fatalf("expected gameType S,R, got %q", got)
+		}
+		if got := query.Get("teamId"); got != "147" {
+			t.Fatalf("expected teamId 147, got %q", got)
+		}
+		if got := query.Get("hydrate"); got != "team,linescore" {
+			t.Fatalf("expected only team and line score hydration by default, got %q", got)
+		}
+		return response(http.StatusOK, `{"dates": []}`)
+	})
+	client := &Client{http: &http.Client{Transport: rt}}
+
+	if _, err := client.FetchSchedule(context.Background(), ScheduleQuery{
+		StartDate: start,
+		EndDate:   end,
+		TeamID:    147,
+		SportID:   11,
+		GameTypes: []string{GameTypeSpringTraining, GameTypeRegularSeason},
+	}); err != nil {
+		t.Fatalf("FetchSchedule returned error: %v", err)
+	}
+}
+
+func TestClientFetchPostseason(t *testing.T) {
+	game := func(gameType, date string, awayID, homeID int, awayWon bool, code, seriesStatus string) string {
+		return fmt.Sprintf(`{
+            "gamePk": %d,
+            "gameDate": %q,
+            "gameType": %q,
+            "seriesDescription": "Series %s",
+            "gamesInSeries": 3,
+            "status": {"abstractGameCode": %q},
+            "seriesStatus": %s,
+            "teams": {
+                "away": {"team": {"id": %d, "abbreviation": "T%d"}, "isWinner": %t},
+                "home": {"team": {"id": %d, "abbreviation": "T%d"}, "isWinner": %t}
+            }
+        }`, awayID*1000+homeID+len(date), date, gameType, gameType, code, seriesStatus, awayID, awayID, awayWon && code == "F", homeID, homeID, !awayWon && code == "F")
+	}
+
+	rt := roundTripFunc(func(req *http.Request) *http.Response {
+		query := req.URL.Query()
+		if got := query.Get("gameType"); got != "F,D,L,W" {
+			t.Fatalf("expected postseason game types, got %q", got)
+		}
+		if got := query.Get("season"); got != "2024" {
+			t.Fatalf("expected season 2024, got %q", got)
+		}
+		if got := query.Get("sportId"); got != "11" {
+			t.Fatalf("expected the requested level, got %q", got)
+		}
+		if !strings.Contains(query.Get("hydrate"), "seriesStatus") {
+			t.Fatalf("expected series status hydration, got %q", query.Get("hydrate"))
+		}
+		body := fmt.Sprintf(`{"dates": [
+            {"date": "2024-10-01", "games": [%s, %s]},
+            {"date": "2024-10-02", "games": [%s]},
+            {"date": "2024-10-05", "games": [%s]}
+        ]}`,
+			game("F", "2024-10-01T18:00:00Z", 2, 1, false, "F", "null"),
+			game("D", "2024-10-01T22:00:00Z", 4, 3, true, "F", `{"gameNumber": 3, "wins": 2, "losses": 1, "winningTeam": {"id": 4}, "losingTeam": {"id": 3}}`),
+			game("F", "2024-10-02T18:00:00Z", 2, 1, true, "F", "null"),
+			game("F", "2024-10-05T18:00:00Z", 1, 2, false, "P", "null"),
+		)
+		return response(http.StatusOK, body)
+	})
+	client := &Client{http: &http.Client{Transport: rt}}
+
+	series, err := client.FetchPostseason(context.Background(), 2024, 11)
+	if err != nil {
+		t.Fatalf("FetchPostseason returned error: %v", err)
+	}
+	if len(series) != 2 {
+		t.Fatalf("expected 2 series, got %d", len(series))
+	}
+
+	wildCard := series[0]
+	if wildCard.GameType != GameTypeWildCard || len(wildCard.Games) != 3 || wildCard.BestOf != 3 {
+		t.Fatalf("unexpected wild card series %+v", wildCard)
+	}
+	if wildCard.High.ID != 1 || wildCard.Low.ID != 2 {
+		t.Fatalf("expected game one's host to be the high seed, got high=%d low=%d", wildCard.High.ID, wildCard.Low.ID)
+	}
+	if wildCard.Wins(1) != 1 || wildCard.Wins(2) != 1 {
+		t.Fatalf("expected a 1-1 series, got %d-%d", wildCard.Wins(1), wildCard.Wins(2))
+	}
+	if _, over := wildCard.Winner(); over {
+		t.Fatalf("expected series to still be in progress")
+	}
+	division := series[1]
+	if division.GameType != GameTypeDivisionSeries {
+		t.Fatalf("expected division series second, got %q", division.GameType)
+	}
+	if division.Wins(4) != 2 || division.Wins(3) != 1 {
+		t.Fatalf("expected wins from the series status, got %d-%d", division.Wins(4), division.Wins(3))
+	}
+}
+
 func TestClientFetchGameSuccess(t *testing.T) {
 	rt := roundTripFunc(func(req *http.Request) *http.Response {
 		if !strings.Contains(req.URL.Path, "/api/v1.1/game/456/feed/live") {
@@ -158,16 +302,54 @@ func TestClientFetchGameSuccess(t *testing.T) {
 	}
 }
 
+func TestClientFetchPitchingGameLog(t *testing.T) {
+	rt := roundTripFunc(func(req *http.Request) *http.Response {
+		if !strings.HasSuffix(req.URL.Path, "/api/v1/people/789/stats") {
+			t.Fatalf("unexpected path: %s", req.URL.Path)
+		}
+		query := req.URL.Query()
+		if query.Get("stats") != "gameLog" || query.Get("group") != "pitching" || query.Get("season") != "2024" {
+			t.Fatalf("unexpected query: %s", req.URL.RawQuery)
+		}
+		body := `{
+            "stats": [{
+                "splits": [
+                    {"date": "2024-04-02", "isHome": true, "opponent": {"id": 147, "name": "New York Yankees"},
+                     "stat": {"gamesStarted": 1, "inningsPitched": "6.0", "earnedRuns": 2, "strikeOuts": 7}},
+                    {"date": "2024-04-08", "isHome": false, "opponent": {"id": 111, "name": "Boston Red Sox"},
+                     "stat": {"gamesStarted": 1, "inningsPitched": "5.1", "earnedRuns": 3, "strikeOuts": 4}}
+                ]
+            }]
+        }`
+		return response(http.StatusOK, body)
+	})
+
+	client := &Client{http: &http.Client{Transport: rt}}
+
+	splits, err := client.FetchPitchingGameLog(context.Background(), 789, 2024)
+	if err != nil {
+		t.Fatalf("FetchPitchingGameLog returned error: %v", err)
+	}
+	if len(splits) != 2 {
+		t.Fatalf("expected 2 splits, got %d", len(splits))
+	}
+	if splits[0].Opponent.Name != "New York Yankees" || splits[1].Stat.InningsPitched != "5.1" {
+		t.Fatalf("unexpected splits: %+v", splits)
+	}
+}
+
 func TestPlayEventTypeUnmarshalJSON(t *testing.T) {
 	testCases := []struct {
-		name string
-		data []byte
-		want string
+		name     string
+		data     []byte
+		want     string
+		wantCode string
 	}{
-		{"null", []byte("null"), ""},
-		{"object", []byte(`{"description":"Called Strike"}`), "Called Strike"},
-		{"string", []byte(`"Swinging Strike"`), "Swinging Strike"},
-		{"empty", []byte{}, ""},
+		{"null", []byte("null"), "", ""},
+		{"object", []byte(`{"description":"Called Strike"}`), "Called Strike", ""},
+		{"pitch type", []byte(`{"code":"SL","description":"Slider"}`), "Slider", "SL"},
+		{"string", []byte(`"Swinging Strike"`), "Swinging Strike", ""},
+		{"empty", []byte{}, "", ""},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -178,6 +360,9 @@ func TestPlayEventTypeUnmarshalJSON(t *testing.T) {
 			if typ.Description != tc.want {
 				t.Fatalf("expected %q, got %q", tc.want, typ.Description)
 			}
+			if typ.Code != tc.wantCode {
+				t.Fatalf("expected code %q, got %q", tc.wantCode, typ.Code)
+			}
 		})
 	}
 }
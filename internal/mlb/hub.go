@@ -0,0 +1,255 @@
+package mlb
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultRetryDelay is how long a resource waits after a failed fetch when the
+// policy doesn't say otherwise.
+const defaultRetryDelay = 15 * time.Second
+
+// HubPolicy decides how often the hub refetches each kind of resource. A
+// function returning false stops polling until a subscriber asks for a refresh.
+// Nil functions fetch once.
+type HubPolicy struct {
+	Game     func(feed *GameFeed) (time.Duration, bool)
+	Schedule func(date time.Time, resp *ScheduleResponse) (time.Duration, bool)
+
+	// RetryDelay is the wait after a failed fetch. Zero uses defaultRetryDelay.
+	RetryDelay time.Duration
+}
+
+// Hub polls game feeds and schedules on behalf of any number of subscribers.
+// Each resource has at most one request in flight, its results are fanned out
+// to every subscriber, and it stops polling once the last subscriber closes.
+type Hub struct {
+	client  *Client
+	context context.Context
+	policy  HubPolicy
+
+	mu        sync.Mutex
+	games     map[int]*resource[*GameFeed]
+	schedules map[scheduleKey]*resource[*ScheduleResponse]
+}
+
+type scheduleKey struct {
+	day       string
+	sportID   int
+	gameTypes string
+}
+
+// Update is one fetch result delivered to a subscriber.
+type Update[T any] struct {
+	Value     T
+	Err       error
+	FetchedAt time.Time
+	// NextPoll is when the resource will be fetched again, or zero when polling has stopped.
+	NextPoll time.Time
+}
+
+// Subscription receives updates for one resource until it is closed.
+type Subscription[T any] struct {
+	updates  chan Update[T]
+	resource *resource[T]
+	closed   bool
+}
+
+// resource is a single polled endpoint and the subscribers sharing it.
+type resource[T any] struct {
+	fetch    func(ctx context.Context) (T, error)
+	interval func(T) (time.Duration, bool)
+	retry    time.Duration
+	// release removes the resource from the hub. It is called with hubMu held.
+	release func()
+	hubMu   *sync.Mutex
+
+	cancel  context.CancelFunc
+	refresh chan struct{}
+
+	mu   sync.Mutex
+	subs map[*Subscription[T]]struct{}
+	last *Update[T]
+}
+
+// NewHub returns a Hub whose requests are cancelled when ctx is.
+func NewHub(ctx context.Context, client *Client, policy HubPolicy) *Hub {
+	if policy.RetryDelay == 0 {
+		policy.RetryDelay = defaultRetryDelay
+	}
+	return &Hub{
+		client:    client,
+		context:   ctx,
+		policy:    policy,
+		games:     make(map[int]*resource[*GameFeed]),
+		schedules: make(map[scheduleKey]*resource[*ScheduleResponse]),
+	}
+}
+
+// SubscribeGame follows a game's live feed.
+func (h *Hub) SubscribeGame(gamePk int) *Subscription[*GameFeed] {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	res, ok := h.games[gamePk]
+	if !ok {
+		res = newResource(h,
+			func(ctx context.Context) (*GameFeed, error) { return h.client.FetchGame(ctx, gamePk) },
+			h.policy.Game,
+			func() { delete(h.games, gamePk) },
+		)
+		h.games[gamePk] = res
+	}
+	return res.subscribe()
+}
+
+// SubscribeSchedule follows one day's schedule for a sport, limited to the
+// given game types. No game types means every type.
+func (h *Hub) SubscribeSchedule(date time.Time, sportID int, gameTypes []string) *Subscription[*ScheduleResponse] {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := scheduleKey{day: date.Format("2006-01-02"), sportID: sportID, gameTypes: strings.Join(gameTypes, ",")}
+	res, ok := h.schedules[key]
+	if !ok {
+		var interval func(*ScheduleResponse) (time.Duration, bool)
+		if h.policy.Schedule != nil {
+			interval = func(resp *ScheduleResponse) (time.Duration, bool) { return h.policy.Schedule(date, resp) }
+		}
+		res = newResource(h,
+			func(ctx context.Context) (*ScheduleResponse, error) {
+				return h.client.FetchSchedule(ctx, ScheduleQuery{Date: date, SportID: sportID, GameTypes: gameTypes, Hydrate: DayScheduleHydrate})
+			},
+			interval,
+			func() { delete(h.schedules, key) },
+		)
+		h.schedules[key] = res
+	}
+	return res.subscribe()
+}
+
+// newResource starts polling a resource. The caller must hold h.mu.
+func newResource[T any](h *Hub, fetch func(context.Context) (T, error), interval func(T) (time.Duration, bool), release func()) *resource[T] {
+	ctx, cancel := context.WithCancel(h.context)
+	res := &resource[T]{
+		fetch:    fetch,
+		interval: interval,
+		retry:    h.policy.RetryDelay,
+		release:  release,
+		hubMu:    &h.mu,
+		cancel:   cancel,
+		refresh:  make(chan struct{}, 1),
+		subs:     make(map[*Subscription[T]]struct{}),
+	}
+	go res.run(ctx)
+	return res
+}
+
+func (r *resource[T]) subscribe() *Subscription[T] {
+	sub := &Subscription[T]{updates: make(chan Update[T], 1), resource: r}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subs[sub] = struct{}{}
+	if r.last != nil {
+		sub.updates <- *r.last
+	}
+	return sub
+}
+
+// run fetches the resource, publishes the result and waits for the next poll,
+// a refresh request or cancellation.
+func (r *resource[T]) run(ctx context.Context) {
+	for {
+		value, err := r.fetch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		// Refreshes asked for while the request was in flight are answered by it.
+		select {
+		case <-r.refresh:
+		default:
+		}
+
+		update := Update[T]{Value: value, Err: err, FetchedAt: time.Now()}
+		wait, poll := r.retry, true
+		if err == nil {
+			wait, poll = 0, false
+			if r.interval != nil {
+				wait, poll = r.interval(value)
+			}
+		}
+		var timer *time.Timer
+		var fire <-chan time.Time
+		if poll {
+			update.NextPoll = update.FetchedAt.Add(wait)
+			timer = time.NewTimer(wait)
+			fire = timer.C
+		}
+		r.publish(update)
+
+		select {
+		case <-ctx.Done():
+		case <-r.refresh:
+		case <-fire:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// publish hands an update to every subscriber, replacing any update a slow
+// subscriber hasn't read yet so nobody falls behind.
+func (r *resource[T]) publish(update Update[T]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.last = &update
+	for sub := range r.subs {
+		select {
+		case <-sub.updates:
+		default:
+		}
+		sub.updates <- update
+	}
+}
+
+// Updates delivers fetch results. It is closed when the subscription is.
+func (s *Subscription[T]) Updates() <-chan Update[T] {
+	return s.updates
+}
+
+// Refresh asks for an immediate fetch. Requests made while one is already in
+// flight share its result.
+func (s *Subscription[T]) Refresh() {
+	select {
+	case s.resource.refresh <- struct{}{}:
+	default:
+	}
+}
+
+// Close stops delivering updates. The resource stops polling, cancelling any
+// request in flight, once its last subscription is closed. Closing twice is safe.
+func (s *Subscription[T]) Close() {
+	r := s.resource
+	r.hubMu.Lock()
+	defer r.hubMu.Unlock()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	delete(r.subs, s)
+	close(s.updates)
+	if len(r.subs) == 0 {
+		r.cancel()
+		r.release()
+	}
+}
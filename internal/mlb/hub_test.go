@@ -0,0 +1,107 @@
+package mlb
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func receive[T any](t *testing.T, sub *Subscription[T]) Update[T] {
+	t.Helper()
+	select {
+	case update, ok := <-sub.Updates():
+		if !ok {
+			t.Fatalf("expected an update, got a closed subscription")
+		}
+		return update
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timed out waiting for an update")
+	}
+	return Update[T]{}
+}
+
+func TestHubSharesGameFetches(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	rt := roundTripFunc(func(req *http.Request) *http.Response {
+		requests.Add(1)
+		<-release
+		return response(http.StatusOK, `{"gamePk": 7}`)
+	})
+	client := &Client{http: &http.Client{Transport: rt}}
+	hub := NewHub(context.Background(), client, HubPolicy{
+		Game: func(*GameFeed) (time.Duration, bool) { return 0, false },
+	})
+
+	first := hub.SubscribeGame(7)
+	second := hub.SubscribeGame(7)
+	close(release)
+
+	for _, sub := range []*Subscription[*GameFeed]{first, second} {
+		if update := receive(t, sub); update.Err != nil || update.Value == nil || !update.NextPoll.IsZero() {
+			t.Fatalf("expected a feed with no further polling, got %+v", update)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("expected subscribers to share one request, got %d", got)
+	}
+
+	late := hub.SubscribeGame(7)
+	if update := receive(t, late); update.Value == nil {
+		t.Fatalf("expected a late subscriber to get the cached feed")
+	}
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("expected the cached feed to be reused, got %d requests", got)
+	}
+
+	late.Refresh()
+	receive(t, first)
+	receive(t, late)
+	if got := requests.Load(); got != 2 {
+		t.Fatalf("expected a refresh to refetch, got %d requests", got)
+	}
+}
+
+func TestHubReleasesClosedResources(t *testing.T) {
+	rt := roundTripFunc(func(*http.Request) *http.Response {
+		return response(http.StatusOK, `{}`)
+	})
+	client := &Client{http: &http.Client{Transport: rt}}
+	hub := NewHub(context.Background(), client, HubPolicy{})
+
+	first := hub.SubscribeGame(7)
+	second := hub.SubscribeGame(7)
+	first.Close()
+	first.Close()
+	if _, ok := <-first.Updates(); ok {
+		t.Fatalf("expected a closed subscription's updates to be closed")
+	}
+	if _, ok := hub.games[7]; !ok {
+		t.Fatalf("expected the game to keep polling for the remaining subscriber")
+	}
+
+	second.Close()
+	if _, ok := hub.games[7]; ok {
+		t.Fatalf("expected the game to be released after its last subscriber closed")
+	}
+}
+
+func TestHubSchedulesHydrateTheDay(t *testing.T) {
+	var hydrate atomic.Value
+	rt := roundTripFunc(func(req *http.Request) *http.Response {
+		hydrate.Store(req.URL.Query().Get("hydrate"))
+		return response(http.StatusOK, `{"dates": []}`)
+	})
+	client := &Client{http: &http.Client{Transport: rt}}
+	hub := NewHub(context.Background(), client, HubPolicy{})
+
+	sub := hub.SubscribeSchedule(time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC), SportMLB, nil)
+	defer sub.Close()
+	receive(t, sub)
+	if got := hydrate.Load(); got != strings.Join(DayScheduleHydrate, ",") {
+		t.Fatalf("expected the day's schedule to hydrate broadcasts, probables and decisions, got %v", got)
+	}
+}
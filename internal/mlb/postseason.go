@@ -0,0 +1,140 @@
+package mlb
+
+import "sort"
+
+// PostseasonRounds lists the postseason game types from the first round to the last.
+var PostseasonRounds = []string{GameTypeWildCard, GameTypeDivisionSeries, GameTypeLeagueSeries, GameTypeWorldSeries}
+
+// PostseasonSeries collects every game between two clubs in one postseason round.
+// High is the club hosting game one, which is the higher seed.
+type PostseasonSeries struct {
+	GameType    string
+	Description string
+	High        TeamInfo
+	Low         TeamInfo
+	Games       []ScheduleGame
+	BestOf      int
+}
+
+// Wins returns the given club's wins in the series, as reported by the API's
+// series status when it was hydrated, otherwise by counting completed games.
+func (s PostseasonSeries) Wins(teamID int) int {
+	if status := s.Status(); status != nil {
+		switch {
+		case status.IsTied && (teamID == s.High.ID || teamID == s.Low.ID):
+			return status.Wins
+		case teamID == status.WinningTeam.ID:
+			return status.Wins
+		case teamID == status.LosingTeam.ID:
+			return status.Losses
+		}
+	}
+
+	wins := 0
+	for _, game := range s.Games {
+		if game.Status.AbstractGameCode != "F" {
+			continue
+		}
+		if (game.Teams.Home.Team.ID == teamID && game.Teams.Home.IsWinner) ||
+			(game.Teams.Away.Team.ID == teamID && game.Teams.Away.IsWinner) {
+			wins++
+		}
+	}
+	return wins
+}
+
+// Winner returns the club that clinched the series, if either has. The API's
+// series status decides when it is present.
+func (s PostseasonSeries) Winner() (TeamInfo, bool) {
+	if status := s.Status(); status != nil {
+		switch {
+		case !status.IsOver:
+			return TeamInfo{}, false
+		case status.WinningTeam.ID == s.High.ID:
+			return s.High, true
+		case status.WinningTeam.ID == s.Low.ID:
+			return s.Low, true
+		}
+	}
+	if s.BestOf <= 0 {
+		return TeamInfo{}, false
+	}
+	needed := s.BestOf/2 + 1
+	switch {
+	case s.Wins(s.High.ID) >= needed:
+		return s.High, true
+	case s.Wins(s.Low.ID) >= needed:
+		return s.Low, true
+	}
+	return TeamInfo{}, false
+}
+
+// Status returns the most recent series status reported by the API, if any.
+func (s PostseasonSeries) Status() *SeriesStatus {
+	for i := len(s.Games) - 1; i >= 0; i-- {
+		if s.Games[i].SeriesStatus != nil && s.Games[i].Status.AbstractGameCode == "F" {
+			return s.Games[i].SeriesStatus
+		}
+	}
+	return nil
+}
+
+// groupPostseasonSeries splits a postseason schedule into series ordered by
+// round, then by the date of each series' first game.
+func groupPostseasonSeries(resp *ScheduleResponse) []PostseasonSeries {
+	type seriesKey struct {
+		gameType string
+		low      int
+		high     int
+	}
+
+	var (
+		series []PostseasonSeries
+		index  = map[seriesKey]int{}
+	)
+	for _, date := range resp.Dates {
+		for _, game := range date.Games {
+			home, away := game.Teams.Home.Team, game.Teams.Away.Team
+			key := seriesKey{gameType: game.GameType, low: min(home.ID, away.ID), high: max(home.ID, away.ID)}
+			idx, ok := index[key]
+			if !ok {
+				idx = len(series)
+				index[key] = idx
+				series = append(series, PostseasonSeries{
+					GameType:    game.GameType,
+					Description: game.SeriesDescription,
+					High:        home,
+					Low:         away,
+				})
+			}
+			series[idx].Games = append(series[idx].Games, game)
+			series[idx].BestOf = max(series[idx].BestOf, game.GamesInSeries)
+		}
+	}
+
+	for i := range series {
+		sort.SliceStable(series[i].Games, func(a, b int) bool {
+			return series[i].Games[a].GameDate.Before(series[i].Games[b].GameDate)
+		})
+		first := series[i].Games[0]
+		series[i].High, series[i].Low = first.Teams.Home.Team, first.Teams.Away.Team
+	}
+
+	sort.SliceStable(series, func(a, b int) bool {
+		ra, rb := roundIndex(series[a].GameType), roundIndex(series[b].GameType)
+		if ra != rb {
+			return ra < rb
+		}
+		return series[a].Games[0].GameDate.Before(series[b].Games[0].GameDate)
+	})
+	return series
+}
+
+func roundIndex(gameType string) int {
+	for idx, round := range PostseasonRounds {
+		if round == gameType {
+			return idx
+		}
+	}
+	return len(PostseasonRounds)
+}
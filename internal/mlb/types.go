@@ -5,12 +5,47 @@ import (
 	"time"
 )
 
+// Sport is a level of play, such as MLB or one of the minor league classes.
+type Sport struct {
+	ID   int
+	Name string
+}
+
+// SportMLB is the StatsAPI sport ID for Major League Baseball.
+const SportMLB = 1
+
+// Sports lists the levels the schedule can show, from the majors down, plus
+// the World Baseball Classic.
+var Sports = []Sport{
+	{ID: SportMLB, Name: "MLB"},
+	{ID: 11, Name: "AAA"},
+	{ID: 12, Name: "AA"},
+	{ID: 13, Name: "High-A"},
+	{ID: 14, Name: "Single-A"},
+	{ID: 16, Name: "Rookie"},
+	{ID: 51, Name: "WBC"},
+}
+
+// Game types used by the schedule endpoint's gameType filter.
+const (
+	GameTypeSpringTraining = "S"
+	GameTypeRegularSeason  = "R"
+	GameTypeExhibition     = "E"
+	GameTypeWildCard       = "F"
+	GameTypeDivisionSeries = "D"
+	GameTypeLeagueSeries   = "L"
+	GameTypeWorldSeries    = "W"
+)
+
 // ScheduleResponse represents the MLB schedule API response.
 type ScheduleResponse struct {
-	Dates []struct {
-		Date  string         `json:"date"`
-		Games []ScheduleGame `json:"games"`
-	} `json:"dates"`
+	Dates []ScheduleDate `json:"dates"`
+}
+
+// ScheduleDate groups the games played on one calendar day.
+type ScheduleDate struct {
+	Date  string         `json:"date"`
+	Games []ScheduleGame `json:"games"`
 }
 
 // ScheduleGame holds the fields surfaced on the schedule screen.
@@ -23,6 +58,66 @@ type ScheduleGame struct {
 	Status       GameStatus     `json:"status"`
 	Linescore    *GameLineScore `json:"linescore"`
 	Teams        ScheduleTeams  `json:"teams"`
+
+	// Suspended games are listed on both the original and the resumption date;
+	// these point from one listing to the other. Postponed games carry RescheduleDate.
+	ResumeDate        time.Time `json:"-"`
+	ResumeDateRaw     string    `json:"resumeDate"`
+	ResumedFrom       time.Time `json:"-"`
+	ResumedFromRaw    string    `json:"resumedFrom"`
+	RescheduleDate    time.Time `json:"-"`
+	RescheduleDateRaw string    `json:"rescheduleDate"`
+
+	Broadcasts []Broadcast `json:"broadcasts"`
+	Decisions  *Decisions  `json:"decisions"`
+
+	GameType          string        `json:"gameType"`
+	SeriesDescription string        `json:"seriesDescription"`
+	SeriesGameNumber  int           `json:"seriesGameNumber"`
+	GamesInSeries     int           `json:"gamesInSeries"`
+	SeriesStatus      *SeriesStatus `json:"seriesStatus"`
+}
+
+// Broadcast is a TV or radio outlet carrying a game.
+type Broadcast struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Language   string `json:"language"`
+	IsNational bool   `json:"isNational"`
+	HomeAway   string `json:"homeAway"`
+	CallSign   string `json:"callSign"`
+}
+
+// IsTV reports whether the outlet is television rather than AM or FM radio.
+func (b Broadcast) IsTV() bool {
+	return b.Type == "TV"
+}
+
+// Doubleheader kinds reported in ScheduleGame.DoubleHeader.
+const (
+	DoubleHeaderNone        = "N"
+	DoubleHeaderTraditional = "Y"
+	DoubleHeaderSplit       = "S"
+)
+
+// IsDoubleHeader reports whether the game is one half of a doubleheader.
+func (g ScheduleGame) IsDoubleHeader() bool {
+	return g.DoubleHeader == DoubleHeaderTraditional || g.DoubleHeader == DoubleHeaderSplit
+}
+
+// SeriesStatus is the state of a postseason series as of one of its games.
+type SeriesStatus struct {
+	GameNumber       int     `json:"gameNumber"`
+	TotalGames       int     `json:"totalGames"`
+	IsTied           bool    `json:"isTied"`
+	IsOver           bool    `json:"isOver"`
+	Wins             int     `json:"wins"`
+	Losses           int     `json:"losses"`
+	WinningTeam      TeamRef `json:"winningTeam"`
+	LosingTeam       TeamRef `json:"losingTeam"`
+	Description      string  `json:"description"`
+	ShortDescription string  `json:"shortDescription"`
+	Result           string  `json:"result"`
 }
 
 // ScheduleTeams groups home/away club info for the schedule view.
@@ -33,15 +128,46 @@ type ScheduleTeams struct {
 
 // ScheduleTeam holds high-level team data for display.
 type ScheduleTeam struct {
-	Team         TeamInfo     `json:"team"`
-	LeagueRecord LeagueRecord `json:"leagueRecord"`
-	IsWinner     bool         `json:"isWinner"`
+	Team            TeamInfo         `json:"team"`
+	LeagueRecord    LeagueRecord     `json:"leagueRecord"`
+	IsWinner        bool             `json:"isWinner"`
+	ProbablePitcher *SchedulePitcher `json:"probablePitcher"`
+}
+
+// SchedulePitcher is a probable starter, with season stats when the schedule
+// hydrates them.
+type SchedulePitcher struct {
+	PersonRef
+	Stats []PitchingStatGroup `json:"stats"`
+}
+
+// PitchingStatGroup is one hydrated stat type, such as the current season.
+type PitchingStatGroup struct {
+	Splits []PitchingStatSplit `json:"splits"`
+}
+
+// PitchingStatSplit is a single line within a PitchingStatGroup.
+type PitchingStatSplit struct {
+	Stat SeasonPitching `json:"stat"`
+}
+
+// Season returns the pitcher's season line, if the schedule included one.
+func (p SchedulePitcher) Season() (SeasonPitching, bool) {
+	for _, group := range p.Stats {
+		if len(group.Splits) > 0 {
+			return group.Splits[0].Stat, true
+		}
+	}
+	return SeasonPitching{}, false
 }
 
 // TeamInfo covers the common name fields.
 type TeamInfo struct {
-	TeamName     string `json:"teamName"`
-	Abbreviation string `json:"abbreviation"`
+	ID           int     `json:"id"`
+	TeamName     string  `json:"teamName"`
+	Abbreviation string  `json:"abbreviation"`
+	League       TeamRef `json:"league"`
+	Division     TeamRef `json:"division"`
 }
 
 // LeagueRecord exposes wins/losses for the current team.
@@ -64,7 +190,12 @@ type GameLineScore struct {
 	CurrentInningOrdinal string          `json:"currentInningOrdinal"`
 	InningState          string          `json:"inningState"`
 	IsTopInning          bool            `json:"isTopInning"`
+	Balls                int             `json:"balls"`
+	Strikes              int             `json:"strikes"`
+	Outs                 int             `json:"outs"`
 	Teams                LineScoreTotals `json:"teams"`
+	Offense              OffensiveState  `json:"offense"`
+	Defense              Defense         `json:"defense"`
 }
 
 // LineScoreTotals shows totals for each club.
@@ -93,13 +224,58 @@ type MetaData struct {
 	TimeStamp string `json:"timeStamp"`
 }
 
+// metaDataTimeLayout is how the feed writes TimeStamp, in UTC.
+const metaDataTimeLayout = "20060102_150405"
+
+// Time returns when the feed was last updated, if TimeStamp is readable.
+func (m MetaData) Time() (time.Time, bool) {
+	t, err := time.ParseInLocation(metaDataTimeLayout, m.TimeStamp, time.UTC)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
 // GameData holds static information about a particular game.
 type GameData struct {
-	Status           GameStatus       `json:"status"`
-	Teams            GameTeams        `json:"teams"`
-	Venue            Venue            `json:"venue"`
-	Datetime         GameDateTime     `json:"datetime"`
-	ProbablePitchers ProbablePitchers `json:"probablePitchers"`
+	Status           GameStatus            `json:"status"`
+	Teams            GameTeams             `json:"teams"`
+	Venue            Venue                 `json:"venue"`
+	Datetime         GameDateTime          `json:"datetime"`
+	ProbablePitchers ProbablePitchers      `json:"probablePitchers"`
+	Players          map[string]GamePlayer `json:"players"`
+	Weather          Weather               `json:"weather"`
+	GameInfo         GameInfo              `json:"gameInfo"`
+}
+
+// Weather is the conditions reported at the ballpark.
+type Weather struct {
+	Condition string `json:"condition"`
+	Temp      string `json:"temp"`
+	Wind      string `json:"wind"`
+}
+
+// GameInfo covers attendance and timing for the game.
+type GameInfo struct {
+	Attendance           int    `json:"attendance"`
+	FirstPitch           string `json:"firstPitch"`
+	GameDurationMinutes  int    `json:"gameDurationMinutes"`
+	DelayDurationMinutes int    `json:"delayDurationMinutes"`
+}
+
+// GamePlayer holds biographical details for everyone on either roster, keyed like the boxscore (e.g. ID12345).
+type GamePlayer struct {
+	ID              int        `json:"id"`
+	FullName        string     `json:"fullName"`
+	BatSide         Handedness `json:"batSide"`
+	PitchHand       Handedness `json:"pitchHand"`
+	PrimaryPosition Position   `json:"primaryPosition"`
+}
+
+// Handedness describes which side a player bats or throws from.
+type Handedness struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
 }
 
 // GameTeams groups home/away teams with more detailed info.
@@ -144,9 +320,10 @@ type ProbablePitchers struct {
 	Away *PersonRef `json:"away"`
 }
 
-// PersonRef references a player by identifier.
+// PersonRef references a player by identifier. Some endpoints include the name inline.
 type PersonRef struct {
-	ID int `json:"id"`
+	ID       int    `json:"id"`
+	FullName string `json:"fullName"`
 }
 
 // LiveData includes the mutable game state.
@@ -174,14 +351,36 @@ type LiveLineScore struct {
 	Outs                 int             `json:"outs"`
 	Teams                LineScoreTotals `json:"teams"`
 	Offense              OffensiveState  `json:"offense"`
+	Defense              Defense         `json:"defense"`
 	Innings              []InningLine    `json:"innings"`
 }
 
-// OffensiveState indicates which bases are occupied.
+// OffensiveState indicates which bases are occupied and who is due up.
 type OffensiveState struct {
 	First  *BaseRunner `json:"first"`
 	Second *BaseRunner `json:"second"`
 	Third  *BaseRunner `json:"third"`
+
+	Batter *PersonRef `json:"batter"`
+	OnDeck *PersonRef `json:"onDeck"`
+	InHole *PersonRef `json:"inHole"`
+}
+
+// Defense lists the fielders currently on the field, plus the fielding club's next hitters.
+type Defense struct {
+	Pitcher   *PersonRef `json:"pitcher"`
+	Catcher   *PersonRef `json:"catcher"`
+	First     *PersonRef `json:"first"`
+	Second    *PersonRef `json:"second"`
+	Third     *PersonRef `json:"third"`
+	Shortstop *PersonRef `json:"shortstop"`
+	Left      *PersonRef `json:"left"`
+	Center    *PersonRef `json:"center"`
+	Right     *PersonRef `json:"right"`
+
+	Batter *PersonRef `json:"batter"`
+	OnDeck *PersonRef `json:"onDeck"`
+	InHole *PersonRef `json:"inHole"`
 }
 
 // BaseRunner is present when a base is occupied.
@@ -254,12 +453,18 @@ type PlayEvent struct {
 	Details       PlayEventDetails `json:"details"`
 	Count         PlayCount        `json:"count"`
 	PitchData     *PitchData       `json:"pitchData"`
+	Defense       *Defense         `json:"defense"`
+	Offense       *OffensiveState  `json:"offense"`
+	// Player is who an action event concerns, such as the pitcher coming in
+	// on a pitching substitution.
+	Player *PersonRef `json:"player"`
 }
 
 // PlayRunner captures how individual runners advance on a play.
 type PlayRunner struct {
 	Movement RunnerMovement `json:"movement"`
 	Details  RunnerDetails  `json:"details"`
+	Credits  []RunnerCredit `json:"credits"`
 }
 
 // RunnerMovement describes the bases a runner traversed.
@@ -280,6 +485,22 @@ type RunnerDetails struct {
 	RBI            bool       `json:"rbi"`
 	Earned         bool       `json:"earned"`
 	IsScoringEvent bool       `json:"isScoringEvent"`
+	PlayIndex      int        `json:"playIndex"`
+}
+
+// RunnerCredit is the official fielding credit (putout, assist, error) tied to a runner movement.
+type RunnerCredit struct {
+	Player   PersonRef `json:"player"`
+	Position Position  `json:"position"`
+	Credit   string    `json:"credit"`
+}
+
+// Position describes a fielding position.
+type Position struct {
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Abbreviation string `json:"abbreviation"`
 }
 
 // RunnerInfo identifies a runner by player id.
@@ -288,11 +509,14 @@ type RunnerInfo struct {
 }
 
 // PlayEventType is used for human-readable descriptions.
+// When the feed provides an object (e.g. pitch types), Code holds the short code such as "FF".
 type PlayEventType struct {
+	Code        string `json:"code"`
 	Description string `json:"description"`
 }
 
 func (t *PlayEventType) UnmarshalJSON(data []byte) error {
+	t.Code = ""
 	if string(data) == "null" {
 		t.Description = ""
 		return nil
@@ -303,11 +527,13 @@ func (t *PlayEventType) UnmarshalJSON(data []byte) error {
 	}
 	if data[0] == '{' {
 		var aux struct {
+			Code        string `json:"code"`
 			Description string `json:"description"`
 		}
 		if err := json.Unmarshal(data, &aux); err != nil {
 			return err
 		}
+		t.Code = aux.Code
 		t.Description = aux.Description
 		return nil
 	}
@@ -321,8 +547,10 @@ func (t *PlayEventType) UnmarshalJSON(data []byte) error {
 
 // PlayEventDetails holds textual descriptions and flags.
 type PlayEventDetails struct {
+	Code          string        `json:"code"`
 	Description   string        `json:"description"`
 	Event         string        `json:"event"`
+	EventType     string        `json:"eventType"`
 	IsInPlay      bool          `json:"isInPlay"`
 	IsStrike      bool          `json:"isStrike"`
 	IsBall        bool          `json:"isBall"`
@@ -341,17 +569,26 @@ type Boxscore struct {
 		Home BoxscoreTeam `json:"home"`
 		Away BoxscoreTeam `json:"away"`
 	} `json:"teams"`
+	Officials []Official `json:"officials"`
+}
+
+// Official is a member of the umpiring crew.
+type Official struct {
+	Official     PersonRef `json:"official"`
+	OfficialType string    `json:"officialType"`
 }
 
 // BoxscoreTeam maps each player by key (e.g. ID12345).
 type BoxscoreTeam struct {
-	Players map[string]BoxscorePlayer `json:"players"`
+	Players      map[string]BoxscorePlayer `json:"players"`
+	BattingOrder []int                     `json:"battingOrder"`
 }
 
 // BoxscorePlayer is used for pitcher/batter details on the matchup card.
 type BoxscorePlayer struct {
 	Person       PersonInfo           `json:"person"`
 	JerseyNumber string               `json:"jerseyNumber"`
+	Position     Position             `json:"position"`
 	Stats        BoxscorePlayerStats  `json:"stats"`
 	SeasonStats  BoxscorePlayerSeason `json:"seasonStats"`
 }
@@ -367,16 +604,24 @@ type BoxscorePlayerStats struct {
 	Batting  BattingStats  `json:"batting"`
 }
 
-// PitchingStats provides info for the matchup panel.
+// PitchingStats provides info for the matchup panel and the final recap.
 type PitchingStats struct {
 	InningsPitched string `json:"inningsPitched"`
 	PitchesThrown  int    `json:"pitchesThrown"`
+	Hits           int    `json:"hits"`
+	EarnedRuns     int    `json:"earnedRuns"`
+	BaseOnBalls    int    `json:"baseOnBalls"`
+	StrikeOuts     int    `json:"strikeOuts"`
 }
 
-// BattingStats summarises at-bats and hits for the current game.
+// BattingStats summarises a player's line for the current game.
 type BattingStats struct {
-	Hits   int `json:"hits"`
-	AtBats int `json:"atBats"`
+	Hits        int `json:"hits"`
+	AtBats      int `json:"atBats"`
+	Runs        int `json:"runs"`
+	HomeRuns    int `json:"homeRuns"`
+	RBI         int `json:"rbi"`
+	BaseOnBalls int `json:"baseOnBalls"`
 }
 
 // BoxscorePlayerSeason tracks season-long performance.
@@ -394,10 +639,14 @@ type SeasonPitching struct {
 	Saves      int    `json:"saves"`
 }
 
-// SeasonBatting contains batting average and home runs.
+// SeasonBatting contains the slash line, home runs, and base stealing.
 type SeasonBatting struct {
-	AVG      string `json:"avg"`
-	HomeRuns int    `json:"homeRuns"`
+	AVG            string `json:"avg"`
+	OBP            string `json:"obp"`
+	SLG            string `json:"slg"`
+	HomeRuns       int    `json:"homeRuns"`
+	StolenBases    int    `json:"stolenBases"`
+	CaughtStealing int    `json:"caughtStealing"`
 }
 
 // Decisions lists the pitcher of record(s).
@@ -406,3 +655,35 @@ type Decisions struct {
 	Loser  *PersonRef `json:"loser"`
 	Save   *PersonRef `json:"save"`
 }
+
+// StatsResponse is returned by the people stats endpoint.
+type StatsResponse struct {
+	Stats []struct {
+		Splits []GameLogSplit `json:"splits"`
+	} `json:"stats"`
+}
+
+// GameLogSplit is a single game from a player's game log.
+type GameLogSplit struct {
+	Date     string      `json:"date"`
+	IsHome   bool        `json:"isHome"`
+	IsWin    bool        `json:"isWin"`
+	Opponent TeamRef     `json:"opponent"`
+	Stat     GameLogStat `json:"stat"`
+}
+
+// TeamRef references a club by identifier and full name.
+type TeamRef struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// GameLogStat holds the pitching line for one game.
+type GameLogStat struct {
+	GamesStarted   int    `json:"gamesStarted"`
+	InningsPitched string `json:"inningsPitched"`
+	Hits           int    `json:"hits"`
+	EarnedRuns     int    `json:"earnedRuns"`
+	BaseOnBalls    int    `json:"baseOnBalls"`
+	StrikeOuts     int    `json:"strikeOuts"`
+}
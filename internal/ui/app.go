@@ -2,10 +2,12 @@ package ui
 
 import (
 	"context"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea/v2"
 	"github.com/charmbracelet/lipgloss/v2"
 
+	"go.dalton.dog/batterup/internal/config"
 	"go.dalton.dog/batterup/internal/mlb"
 	"go.dalton.dog/batterup/internal/styles"
 )
@@ -16,32 +18,69 @@ type ModelIndex int
 const (
 	viewSchedule ModelIndex = iota
 	viewGame
+	viewTeamSchedule
+	viewPostseason
 )
 
 // Model orchestrates the entire Bubble Tea program.
 type Model struct {
 	ctx    context.Context
 	cancel context.CancelFunc
+	clock  displayClock
+	// ticking is set while a statusTick is pending.
+	ticking bool
 
-	curModel ModelIndex
-	schedule ScheduleModel
-	game     GameModel
+	curModel   ModelIndex
+	schedule   ScheduleModel
+	game       GameModel
+	team       TeamScheduleModel
+	postseason PostseasonModel
+
+	// gameReturn is the screen to go back to when the game view closes.
+	gameReturn ModelIndex
 
 	width  int
 	height int
 }
 
+// Options configures the initial state of the TUI.
+type Options struct {
+	// Date is the schedule day shown at startup. The zero value means today.
+	Date time.Time
+
+	// Location is the timezone every time is displayed in. Nil means the system zone.
+	Location *time.Location
+
+	// DayRolloverHour is the hour at which "today" advances to the next baseball day.
+	DayRolloverHour int
+
+	// Team is the abbreviation of the followed club, used to pick local broadcasts
+	// and for the favorite-first sort.
+	Team string
+
+	// Schedule is the schedule's initial sort and filter.
+	Schedule config.Schedule
+
+	// SaveSchedule persists sort and filter changes. Nil disables saving.
+	SaveSchedule func(config.Schedule) error
+}
+
 // NewAppModel constructs the Bubble Tea model.
-func NewAppModel(client *mlb.Client) Model {
+func NewAppModel(client *mlb.Client, opts Options) Model {
 	ctx, cancel := context.WithCancel(context.Background())
+	clock := newDisplayClock(opts.Location, opts.DayRolloverHour)
+	hub := mlb.NewHub(ctx, client, newHubPolicy(clock))
 
 	m := Model{
 		ctx:      ctx,
 		cancel:   cancel,
 		curModel: viewSchedule,
+		clock:    clock,
 
-		schedule: NewScheduleModel(client, ctx),
-		game:     NewGameModel(client, ctx),
+		schedule:   NewScheduleModel(client, hub, ctx, clock, opts),
+		game:       NewGameModel(client, hub, ctx, clock),
+		team:       NewTeamScheduleModel(client, ctx, clock),
+		postseason: NewPostseasonModel(client, ctx, clock, opts.Team),
 	}
 
 	return m
@@ -52,8 +91,22 @@ func (m Model) Init() tea.Cmd {
 	return m.schedule.Init()
 }
 
-// Update reacts to incoming messages and user input.
+// Update reacts to incoming messages and user input, then keeps the status
+// line's countdown running for as long as the current view is polling.
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(statusTickMsg); ok {
+		m.ticking = false
+	}
+	model, cmd := m.update(msg)
+	m = model.(Model)
+	if status, ok := m.feedStatus(); ok && !status.nextPoll.IsZero() && !m.ticking {
+		m.ticking = true
+		cmd = tea.Batch(cmd, statusTick())
+	}
+	return m, cmd
+}
+
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var (
 		cmds           []tea.Cmd
 		gameCmd        tea.Cmd
@@ -61,29 +114,68 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	)
 
 	switch msg := msg.(type) {
+	case statusTickMsg:
+		return m, nil
+	case schedulePrefsSaveMsg, schedulePrefsSavedMsg:
+		// A sort or filter change still settling when another view opened is
+		// saved all the same.
+		model, cmd := m.schedule.Update(msg)
+		m.schedule = model.(ScheduleModel)
+		return m, cmd
+	case scheduleSubscribedMsg:
+		// The schedule only sees messages while shown, and resubscribes when it
+		// comes back, so a subscription that opened after it was hidden is dropped.
+		if m.curModel != viewSchedule {
+			msg.subscription.Close()
+			return m, nil
+		}
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
 		m.schedule.SetSize(msg.Width, msg.Height-2) // Account for header and footer
 		m.game.SetSize(msg.Width, msg.Height-2)
+		m.team.SetSize(msg.Width, msg.Height-2)
+		m.postseason.SetSize(msg.Width, msg.Height-2)
 
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c":
 			m.cancel()
-			return m, tea.Quit
+			return m, tea.Sequence(m.schedule.savePrefs(), tea.Quit)
 		case "esc", "q":
-			if m.curModel == viewGame {
-				m.curModel = viewSchedule
+			if m.curModel == viewGame && !m.game.InSubScreen() {
+				m.curModel = m.gameReturn
 				m.game.SetActive(false)
-				m.schedule.SetActive(true)
-				return m, nil
+				return m, m.resume()
+			}
+			if m.curModel == viewTeamSchedule || (m.curModel == viewPostseason && !m.postseason.InSubScreen()) {
+				m.team.SetActive(false)
+				m.postseason.SetActive(false)
+				m.curModel = viewSchedule
+				return m, m.resume()
 			}
 		}
 
+	case openTeamScheduleMsg:
+		m.curModel = viewTeamSchedule
+		m.schedule.SetActive(false)
+		var cmd tea.Cmd
+		m.team, cmd = m.team.Update(msg)
+		return m, cmd
+
+	case openPostseasonMsg:
+		m.curModel = viewPostseason
+		m.schedule.SetActive(false)
+		var cmd tea.Cmd
+		m.postseason, cmd = m.postseason.Update(msg)
+		return m, cmd
+
 	case openGameMsg:
+		m.gameReturn = m.curModel
 		m.curModel = viewGame
 		m.schedule.SetActive(false)
+		m.team.SetActive(false)
+		m.postseason.SetActive(false)
 		m.game.SetActive(true)
 		if m.width > 0 && m.height > 0 {
 			m.game.SetSize(m.width, m.height-2)
@@ -94,8 +186,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	if m.curModel != viewSchedule {
 		m.schedule.SetActive(false)
-	} else {
-		m.schedule.SetActive(true)
+	} else if cmd := m.schedule.SetActive(true); cmd != nil {
+		cmds = append(cmds, cmd)
 	}
 
 	if m.curModel == viewSchedule {
@@ -118,13 +210,33 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	if m.curModel == viewPostseason {
+		var cmd tea.Cmd
+		m.postseason, cmd = m.postseason.Update(msg)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	if m.curModel == viewTeamSchedule {
+		var cmd tea.Cmd
+		m.team, cmd = m.team.Update(msg)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
 	return m, tea.Batch(cmds...)
 }
 
 // View renders the entire screen for the current state.
 func (m Model) View() string {
 	header := styles.AppHeaderStyle.Width(m.width).Render("Batter Up!")
-	footer := styles.AppHeaderStyle.Width(m.width).Render("https://github.com/daltonsw/batterup")
+	footerText := "https://github.com/daltonsw/batterup"
+	if status := m.status(); status != "" {
+		footerText = status
+	}
+	footer := styles.AppHeaderStyle.Width(m.width).Render(footerText)
 
 	var content string
 	switch m.curModel {
@@ -132,6 +244,10 @@ func (m Model) View() string {
 		content = m.schedule.View()
 	case viewGame:
 		content = m.game.View()
+	case viewTeamSchedule:
+		content = m.team.View()
+	case viewPostseason:
+		content = m.postseason.View()
 	}
 
 	if m.height <= 0 {
@@ -143,6 +259,41 @@ func (m Model) View() string {
 	return lipgloss.JoinVertical(lipgloss.Center, header, content, footer)
 }
 
+// feedStatus reports how fresh the current view's data is. Views that don't
+// poll have none.
+func (m Model) feedStatus() (feedStatus, bool) {
+	switch m.curModel {
+	case viewSchedule:
+		return m.schedule.status, true
+	case viewGame:
+		return m.game.status, true
+	}
+	return feedStatus{}, false
+}
+
+// status renders the current view's feedStatus for the footer.
+func (m Model) status() string {
+	status, ok := m.feedStatus()
+	if !ok {
+		return ""
+	}
+	return status.render(m.clock.Now())
+}
+
+// resume reactivates the view being returned to, picking up anything it had
+// to drop or cancel while hidden.
+func (m *Model) resume() tea.Cmd {
+	switch m.curModel {
+	case viewSchedule:
+		return m.schedule.SetActive(true)
+	case viewTeamSchedule:
+		return m.team.SetActive(true)
+	case viewPostseason:
+		return m.postseason.SetActive(true)
+	}
+	return nil
+}
+
 func (m *Model) Cancel() {
 	if m.cancel != nil {
 		m.cancel()
@@ -152,4 +303,7 @@ func (m *Model) Cancel() {
 // openGameMsg instructs the root model to enter the game view.
 type openGameMsg struct {
 	GameID int
+
+	// Broadcasts lists outlets known from the schedule, since the game feed has none.
+	Broadcasts []mlb.Broadcast
 }
@@ -0,0 +1,176 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+const (
+	baseHome   = 0
+	baseScored = 4
+	baseOut    = -1
+
+	baseAnimationFrameDelay = 250 * time.Millisecond
+)
+
+// baseAnimation steps runners one base per frame from the previous
+// play's base state to the newest one.
+type baseAnimation struct {
+	paths  []runnerPath
+	frame  int
+	frames int
+}
+
+// runnerPath is a single runner's journey during an animation. Bases are
+// numbered 0 (home) through 3, with baseScored and baseOut as terminal states.
+type runnerPath struct {
+	id   int
+	from int
+	to   int
+}
+
+type baseAnimationFrameMsg struct {
+	gameID int
+	seq    int
+}
+
+// planBaseAnimation compares the bases after prevPlay with those after play and
+// builds the movement for each runner. Runners who vanish are only animated
+// home or out when play says so; otherwise they scored or were stranded on a
+// play the refresh skipped past, and are dropped. A new half-inning starts
+// from empty bases. It returns nil when nothing moved.
+func planBaseAnimation(prev, next mlb.OffensiveState, prevPlay, play mlb.Play) *baseAnimation {
+	before := basesByRunner(prev)
+	if prevPlay.About.Inning != play.About.Inning || prevPlay.About.IsTopInning != play.About.IsTopInning {
+		before = map[int]int{}
+	}
+	after := basesByRunner(next)
+
+	var paths []runnerPath
+	for id, to := range after {
+		from, ok := before[id]
+		if !ok {
+			from = baseHome
+		}
+		if from != to {
+			paths = append(paths, runnerPath{id: id, from: from, to: to})
+		}
+	}
+	for id, from := range before {
+		if _, ok := after[id]; ok {
+			continue
+		}
+		switch {
+		case runnerWasOut(play, id):
+			paths = append(paths, runnerPath{id: id, from: from, to: baseOut})
+		case runnerScored(play, id):
+			paths = append(paths, runnerPath{id: id, from: from, to: baseScored})
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	frames := 1
+	for _, path := range paths {
+		if path.to > path.from {
+			frames = max(frames, path.to-path.from)
+		}
+	}
+	return &baseAnimation{paths: paths, frames: frames}
+}
+
+// offense reports where every runner stands on the current frame.
+func (a *baseAnimation) offense(final mlb.OffensiveState) mlb.OffensiveState {
+	if a == nil || a.done() {
+		return final
+	}
+	bases := map[int]int{}
+	// Runners who did not move keep their base.
+	for id, base := range basesByRunner(final) {
+		bases[base] = id
+	}
+	for _, path := range a.paths {
+		if bases[path.to] == path.id {
+			delete(bases, path.to)
+		}
+	}
+	for _, path := range a.paths {
+		base := path.at(a.frame)
+		if base >= 1 && base <= 3 {
+			bases[base] = path.id
+		}
+	}
+	return mlb.OffensiveState{
+		First:  baseRunnerPtr(bases[1]),
+		Second: baseRunnerPtr(bases[2]),
+		Third:  baseRunnerPtr(bases[3]),
+	}
+}
+
+func (p runnerPath) at(frame int) int {
+	if p.to == baseOut {
+		return p.from
+	}
+	if p.to < p.from {
+		return p.to
+	}
+	return min(p.from+frame, p.to)
+}
+
+func (a *baseAnimation) done() bool {
+	return a == nil || a.frame >= a.frames
+}
+
+// next returns a copy of the animation advanced by one frame, leaving the
+// receiver untouched so earlier model values stay consistent.
+func (a *baseAnimation) next() *baseAnimation {
+	if a == nil {
+		return nil
+	}
+	advanced := *a
+	advanced.frame++
+	return &advanced
+}
+
+func basesByRunner(offense mlb.OffensiveState) map[int]int {
+	bases := map[int]int{}
+	if offense.First != nil {
+		bases[offense.First.ID] = 1
+	}
+	if offense.Second != nil {
+		bases[offense.Second.ID] = 2
+	}
+	if offense.Third != nil {
+		bases[offense.Third.ID] = 3
+	}
+	return bases
+}
+
+func runnerWasOut(play mlb.Play, id int) bool {
+	for _, runner := range play.Runners {
+		if runner.Details.Runner.ID == id && runner.Movement.IsOut {
+			return true
+		}
+	}
+	return false
+}
+
+// runnerScored reports whether play shows the runner reaching home safely.
+func runnerScored(play mlb.Play, id int) bool {
+	for _, runner := range play.Runners {
+		if runner.Details.Runner.ID == id && !runner.Movement.IsOut && isHomePlate(runner.Movement.End) {
+			return true
+		}
+	}
+	return false
+}
+
+func baseAnimationTick(gameID, seq int) tea.Cmd {
+	return tea.Tick(baseAnimationFrameDelay, func(time.Time) tea.Msg {
+		return baseAnimationFrameMsg{gameID: gameID, seq: seq}
+	})
+}
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"testing"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func TestPlanBaseAnimationStepsRunnersOneBaseAtATime(t *testing.T) {
+	prev := mlb.OffensiveState{First: &mlb.BaseRunner{ID: 1}, Third: &mlb.BaseRunner{ID: 3}}
+	next := mlb.OffensiveState{First: &mlb.BaseRunner{ID: 9}, Third: &mlb.BaseRunner{ID: 1}}
+
+	anim := planBaseAnimation(prev, next, mlb.Play{}, mlb.Play{Runners: []mlb.PlayRunner{{
+		Movement: mlb.RunnerMovement{Start: "3B", End: "score"},
+		Details:  mlb.RunnerDetails{Runner: mlb.RunnerInfo{ID: 3}},
+	}}})
+	if anim == nil {
+		t.Fatalf("expected an animation when runners move")
+	}
+	if anim.frames != 2 {
+		t.Fatalf("expected two frames for a first-to-third advance, got %d", anim.frames)
+	}
+
+	start := anim.offense(next)
+	if start.First == nil || start.First.ID != 1 || start.Third == nil || start.Third.ID != 3 {
+		t.Fatalf("expected first frame to match the previous base state, got %+v", basesByRunner(start))
+	}
+
+	anim = anim.next()
+	mid := anim.offense(next)
+	if mid.Second == nil || mid.Second.ID != 1 {
+		t.Fatalf("expected runner 1 to pass through second, got %+v", basesByRunner(mid))
+	}
+	if mid.First == nil || mid.First.ID != 9 {
+		t.Fatalf("expected batter to reach first, got %+v", basesByRunner(mid))
+	}
+	if mid.Third != nil {
+		t.Fatalf("expected scoring runner to leave third, got %+v", basesByRunner(mid))
+	}
+
+	anim = anim.next()
+	if !anim.done() {
+		t.Fatalf("expected animation to finish after its last frame")
+	}
+	if final := anim.offense(next); final.Third == nil || final.Third.ID != 1 {
+		t.Fatalf("expected finished animation to show the final state")
+	}
+}
+
+func TestPlanBaseAnimationKeepsOutRunnerUntilDone(t *testing.T) {
+	prev := mlb.OffensiveState{Second: &mlb.BaseRunner{ID: 2}}
+	play := mlb.Play{Runners: []mlb.PlayRunner{{
+		Movement: mlb.RunnerMovement{Start: "2B", IsOut: true},
+		Details:  mlb.RunnerDetails{Runner: mlb.RunnerInfo{ID: 2}},
+	}}}
+
+	anim := planBaseAnimation(prev, mlb.OffensiveState{}, mlb.Play{}, play)
+	if anim == nil || anim.paths[0].to != baseOut {
+		t.Fatalf("expected runner to be marked out, got %+v", anim)
+	}
+	if frame := anim.offense(mlb.OffensiveState{}); frame.Second == nil {
+		t.Fatalf("expected out runner to remain visible on the first frame")
+	}
+}
+
+func TestPlanBaseAnimationNoMovement(t *testing.T) {
+	state := mlb.OffensiveState{First: &mlb.BaseRunner{ID: 1}}
+	if anim := planBaseAnimation(state, state, mlb.Play{}, mlb.Play{}); anim != nil {
+		t.Fatalf("expected no animation when bases are unchanged")
+	}
+}
+
+func TestPlanBaseAnimationDropsRunnersStrandedAcrossHalfInnings(t *testing.T) {
+	prev := mlb.OffensiveState{Second: &mlb.BaseRunner{ID: 2}, Third: &mlb.BaseRunner{ID: 3}}
+	prevPlay := mlb.Play{About: mlb.PlayAbout{Inning: 4, IsTopInning: true}}
+	play := mlb.Play{About: mlb.PlayAbout{Inning: 4, IsTopInning: false}}
+	next := mlb.OffensiveState{First: &mlb.BaseRunner{ID: 9}}
+
+	anim := planBaseAnimation(prev, next, prevPlay, play)
+	if anim == nil || len(anim.paths) != 1 || anim.paths[0].id != 9 || anim.paths[0].from != baseHome {
+		t.Fatalf("expected only the new batter to move, got %+v", anim)
+	}
+
+	// Within the half, a runner missing without a record on the latest play
+	// left on a play the refresh skipped past.
+	if anim := planBaseAnimation(prev, mlb.OffensiveState{}, prevPlay, prevPlay); anim != nil {
+		t.Fatalf("expected unexplained departures not to be animated as runs, got %+v", anim.paths)
+	}
+}
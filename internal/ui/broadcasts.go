@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"strings"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+// relevantBroadcasts keeps the English-language outlets worth listing for a game:
+// national broadcasts, plus the local ones for the followed team when it is
+// playing, or for both clubs otherwise.
+func relevantBroadcasts(game mlb.ScheduleGame, team string) []mlb.Broadcast {
+	side := ""
+	switch {
+	case team == "":
+	case strings.EqualFold(game.Teams.Home.Team.Abbreviation, team):
+		side = "home"
+	case strings.EqualFold(game.Teams.Away.Team.Abbreviation, team):
+		side = "away"
+	}
+
+	var relevant []mlb.Broadcast
+	for _, broadcast := range game.Broadcasts {
+		if broadcast.Language != "" && broadcast.Language != "en" {
+			continue
+		}
+		if !broadcast.IsNational && side != "" && broadcast.HomeAway != side {
+			continue
+		}
+		relevant = append(relevant, broadcast)
+	}
+	return relevant
+}
+
+// formatBroadcasts lists TV or radio outlet names once each, national first.
+func formatBroadcasts(broadcasts []mlb.Broadcast, tv bool) string {
+	var national, local []string
+	seen := map[string]bool{}
+	for _, broadcast := range broadcasts {
+		if broadcast.IsTV() != tv || broadcast.Name == "" || seen[broadcast.Name] {
+			continue
+		}
+		seen[broadcast.Name] = true
+		if broadcast.IsNational {
+			national = append(national, broadcast.Name)
+		} else {
+			local = append(local, broadcast.Name)
+		}
+	}
+	return strings.Join(append(national, local...), ", ")
+}
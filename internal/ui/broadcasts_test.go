@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"testing"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func broadcastGame() mlb.ScheduleGame {
+	game := teamScheduleGame(1, 2, 0, 0)
+	game.Broadcasts = []mlb.Broadcast{
+		{Name: "ESPN", Type: "TV", Language: "en", IsNational: true},
+		{Name: "AWY Sports", Type: "TV", Language: "en", HomeAway: "away"},
+		{Name: "HME Net", Type: "TV", Language: "en", HomeAway: "home"},
+		{Name: "HME Net", Type: "TV", Language: "en", HomeAway: "home"},
+		{Name: "KHME", Type: "AM", Language: "en", HomeAway: "home"},
+		{Name: "KHME Deportes", Type: "AM", Language: "es", HomeAway: "home"},
+	}
+	return game
+}
+
+func TestRelevantBroadcastsFiltersByTeam(t *testing.T) {
+	game := broadcastGame()
+
+	if got := formatBroadcasts(relevantBroadcasts(game, "hme"), true); got != "ESPN, HME Net" {
+		t.Fatalf("expected national and home TV for the followed team, got %q", got)
+	}
+	if got := formatBroadcasts(relevantBroadcasts(game, ""), true); got != "ESPN, AWY Sports, HME Net" {
+		t.Fatalf("expected every TV outlet without a followed team, got %q", got)
+	}
+	if got := formatBroadcasts(relevantBroadcasts(game, "NYY"), false); got != "KHME" {
+		t.Fatalf("expected English radio only, got %q", got)
+	}
+}
@@ -0,0 +1,212 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+
+	"go.dalton.dog/batterup/internal/mlb"
+	"go.dalton.dog/batterup/internal/styles"
+)
+
+// CalendarModel is a month grid used to jump the schedule to any date.
+// Each day shows how many games are scheduled, with off-days dimmed.
+type CalendarModel struct {
+	clock   displayClock
+	cursor  time.Time
+	counts  map[string]int
+	loaded  bool
+	loading bool
+	err     error
+}
+
+type calendarCountsMsg struct {
+	month  time.Time
+	counts map[string]int
+}
+
+type calendarFailedMsg struct {
+	month time.Time
+	err   error
+}
+
+const calendarDayKey = "2006-01-02"
+
+func NewCalendarModel(date time.Time, clock displayClock) CalendarModel {
+	return CalendarModel{clock: clock, cursor: truncateToDay(date)}
+}
+
+// Cursor returns the highlighted date.
+func (c CalendarModel) Cursor() time.Time {
+	return c.cursor
+}
+
+// Month returns the first day of the month being shown.
+func (c CalendarModel) Month() time.Time {
+	return firstOfMonth(c.cursor)
+}
+
+// SetCounts stores the number of games for each day of the shown month.
+func (c *CalendarModel) SetCounts(month time.Time, counts map[string]int) {
+	if !sameMonth(month, c.cursor) {
+		return
+	}
+	c.counts = counts
+	c.loaded = true
+	c.loading = false
+	c.err = nil
+}
+
+// SetError records a failed month load.
+func (c *CalendarModel) SetError(month time.Time, err error) {
+	if !sameMonth(month, c.cursor) {
+		return
+	}
+	c.loading = false
+	c.err = err
+}
+
+// Update moves the cursor. It reports whether the month changed so the
+// owner can load game counts for it.
+func (c CalendarModel) Update(msg tea.Msg) (CalendarModel, bool) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return c, false
+	}
+
+	month := c.Month()
+	switch key.String() {
+	case "h", "left":
+		c.cursor = c.cursor.AddDate(0, 0, -1)
+	case "l", "right":
+		c.cursor = c.cursor.AddDate(0, 0, 1)
+	case "k", "up":
+		c.cursor = c.cursor.AddDate(0, 0, -7)
+	case "j", "down":
+		c.cursor = c.cursor.AddDate(0, 0, 7)
+	case "[", "pgup":
+		c.cursor = addMonthsClamped(c.cursor, -1)
+	case "]", "pgdown":
+		c.cursor = addMonthsClamped(c.cursor, 1)
+	case "t", "T":
+		c.cursor = c.clock.Today()
+	}
+
+	if !c.Month().Equal(month) {
+		c.counts = nil
+		c.loaded = false
+		c.loading = true
+		c.err = nil
+		return c, true
+	}
+	return c, false
+}
+
+func (c CalendarModel) View() string {
+	month := c.Month()
+	title := calendarTitleStyle.Render(month.Format("January 2006"))
+
+	weekdays := make([]string, 0, 7)
+	for _, day := range []string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"} {
+		weekdays = append(weekdays, calendarCellStyle.Render(styles.ScheduleTableHeader.Render(day)))
+	}
+	rows := []string{lipgloss.JoinHorizontal(lipgloss.Top, weekdays...)}
+
+	day := month.AddDate(0, 0, -int(month.Weekday()))
+	for week := 0; week < 6; week++ {
+		if week > 0 && day.Month() != month.Month() {
+			break
+		}
+		cells := make([]string, 0, 7)
+		for range 7 {
+			cells = append(cells, c.renderDay(day, month))
+			day = day.AddDate(0, 0, 1)
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+	}
+
+	status := ""
+	switch {
+	case requestCancelled(c.err):
+		status = renderCancelled("games")
+	case c.err != nil:
+		status = lipgloss.NewStyle().Foreground(lipgloss.Red).Render("Error loading games: " + c.err.Error())
+	case c.loading && !c.loaded:
+		status = "Loading games…"
+	}
+
+	parts := []string{title, lipgloss.JoinVertical(lipgloss.Left, rows...)}
+	if status != "" {
+		parts = append(parts, status)
+	}
+	parts = append(parts, styles.HelpTextStyle.Render("hjkl to move • [ / ] month • t today • enter to jump • esc to close"))
+
+	return calendarWrapperStyle.Render(lipgloss.JoinVertical(lipgloss.Center, parts...))
+}
+
+func (c CalendarModel) renderDay(day, month time.Time) string {
+	if day.Month() != month.Month() {
+		return calendarCellStyle.Render("")
+	}
+
+	label := fmt.Sprintf("%2d", day.Day())
+	count, known := c.counts[day.Format(calendarDayKey)]
+	games := ""
+	switch {
+	case !c.loaded:
+		games = "  "
+	case count > 0:
+		games = fmt.Sprintf("%2dg", count)
+	default:
+		games = " - "
+	}
+
+	text := label + "\n" + games
+	style := calendarCellStyle
+	if c.loaded && (!known || count == 0) {
+		style = style.Faint(true)
+	}
+	if sameDay(day, c.clock.Today()) {
+		style = style.Foreground(lipgloss.Cyan).Bold(true)
+	}
+	if sameDay(day, c.cursor) {
+		style = style.Reverse(true).Faint(false)
+	}
+	return style.Render(text)
+}
+
+// countGamesByDay tallies games per day from a schedule response's date buckets.
+func countGamesByDay(resp *mlb.ScheduleResponse) map[string]int {
+	counts := make(map[string]int, len(resp.Dates))
+	for _, date := range resp.Dates {
+		counts[date.Date] += len(date.Games)
+	}
+	return counts
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func firstOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+func sameMonth(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month()
+}
+
+// addMonthsClamped moves by whole months, clamping the day so Jan 31 + 1 month is Feb 28/29.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	first := firstOfMonth(t).AddDate(0, months, 0)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return time.Date(first.Year(), first.Month(), min(t.Day(), lastDay), 0, 0, 0, 0, t.Location())
+}
+
+var (
+	calendarWrapperStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	calendarTitleStyle   = lipgloss.NewStyle().Bold(true).Padding(0, 0, 1)
+	calendarCellStyle    = lipgloss.NewStyle().Width(5).Align(lipgloss.Center)
+)
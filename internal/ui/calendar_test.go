@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func keyPress(text string) tea.KeyMsg {
+	runes := []rune(text)
+	return tea.KeyPressMsg{Code: runes[0], Text: text}
+}
+
+func TestAddMonthsClamped(t *testing.T) {
+	jan31 := time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)
+	if got := addMonthsClamped(jan31, 1); !sameDay(got, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected leap day clamp, got %v", got)
+	}
+	if got := addMonthsClamped(jan31, -1); !sameDay(got, time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected previous December, got %v", got)
+	}
+}
+
+func TestCalendarUpdateReportsMonthChange(t *testing.T) {
+	cal := NewCalendarModel(time.Date(2024, time.June, 28, 15, 0, 0, 0, time.UTC), displayClock{})
+
+	cal, changed := cal.Update(keyPress("h"))
+	if changed || cal.Cursor().Day() != 27 {
+		t.Fatalf("expected to stay in June on day 27, got %v changed=%v", cal.Cursor(), changed)
+	}
+
+	cal, changed = cal.Update(keyPress("j"))
+	if !changed || cal.Month().Month() != time.July {
+		t.Fatalf("expected week jump into July to report a month change, got %v", cal.Cursor())
+	}
+	if !cal.loading || cal.loaded {
+		t.Fatalf("expected counts to reset while the new month loads")
+	}
+}
+
+func TestCalendarSetCountsIgnoresOtherMonths(t *testing.T) {
+	cal := NewCalendarModel(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC), displayClock{})
+	cal.SetCounts(time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC), map[string]int{"2024-05-01": 15})
+	if cal.loaded {
+		t.Fatalf("expected stale month counts to be ignored")
+	}
+
+	cal.SetCounts(cal.Month(), map[string]int{"2024-06-03": 12})
+	out := cal.View()
+	if !strings.Contains(out, "June 2024") || !strings.Contains(out, "12g") {
+		t.Fatalf("expected month title and game count, got %q", out)
+	}
+}
+
+func TestCountGamesByDay(t *testing.T) {
+	resp := &mlb.ScheduleResponse{Dates: []mlb.ScheduleDate{
+		{Date: "2024-06-03", Games: make([]mlb.ScheduleGame, 3)},
+		{Date: "2024-06-04", Games: make([]mlb.ScheduleGame, 1)},
+	}}
+
+	counts := countGamesByDay(resp)
+	if counts["2024-06-03"] != 3 {
+		t.Fatalf("expected 3 games, got %v", counts)
+	}
+}
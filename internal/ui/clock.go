@@ -0,0 +1,54 @@
+package ui
+
+import "time"
+
+// displayClock decides which timezone times are shown in and when one
+// baseball day ends and the next begins. NewAppModel builds one from Options
+// and hands it to every screen so dates and start times always agree. The zero
+// value shows local time with days ending at midnight.
+type displayClock struct {
+	location *time.Location
+	rollover time.Duration
+	now      func() time.Time
+}
+
+func newDisplayClock(location *time.Location, rolloverHour int) displayClock {
+	if location == nil {
+		location = time.Local
+	}
+	return displayClock{
+		location: location,
+		rollover: time.Duration(rolloverHour) * time.Hour,
+		now:      time.Now,
+	}
+}
+
+// Now is the current instant in the display timezone.
+func (c displayClock) Now() time.Time {
+	return c.In(c.instant())
+}
+
+// In converts an instant to the display timezone.
+func (c displayClock) In(t time.Time) time.Time {
+	if c.location == nil {
+		return t.In(time.Local)
+	}
+	return t.In(c.location)
+}
+
+// Today is the current baseball day, which only advances at the rollover hour.
+func (c displayClock) Today() time.Time {
+	return c.BaseballDay(c.instant())
+}
+
+// BaseballDay returns midnight of the baseball day an instant falls on.
+func (c displayClock) BaseballDay(t time.Time) time.Time {
+	return truncateToDay(c.In(t).Add(-c.rollover))
+}
+
+func (c displayClock) instant() time.Time {
+	if c.now == nil {
+		return time.Now()
+	}
+	return c.now()
+}
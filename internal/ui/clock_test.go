@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func TestDisplayClockRollover(t *testing.T) {
+	pacific := time.FixedZone("PDT", -7*3600)
+	c := newDisplayClock(pacific, 6)
+	c.now = func() time.Time { return time.Date(2024, time.June, 13, 9, 30, 0, 0, time.UTC) } // 2:30 AM PDT
+
+	today := c.Today()
+	if today.Day() != 12 || today.Location() != pacific {
+		t.Fatalf("expected the baseball day to still be June 12 in PDT, got %v", today)
+	}
+
+	c.now = func() time.Time { return time.Date(2024, time.June, 13, 13, 0, 0, 0, time.UTC) } // 6:00 AM PDT
+	if today := c.Today(); today.Day() != 13 {
+		t.Fatalf("expected the day to roll over at 6 AM, got %v", today)
+	}
+}
+
+func TestSameDayUsesDisplayZone(t *testing.T) {
+	clock := newDisplayClock(time.FixedZone("PDT", -7*3600), 0)
+
+	lateGame := time.Date(2024, time.June, 13, 2, 10, 0, 0, time.UTC) // 7:10 PM PDT on June 12
+	day := clock.BaseballDay(lateGame)
+	if !sameDay(day, lateGame) || day.Day() != 12 {
+		t.Fatalf("expected a late West Coast start to land on June 12")
+	}
+}
+
+func TestDescribeGameStatusUsesDisplayZone(t *testing.T) {
+	clock := newDisplayClock(time.FixedZone("CDT", -5*3600), 0)
+
+	game := mlb.ScheduleGame{
+		Status:   mlb.GameStatus{AbstractGameCode: "P", DetailedState: "Scheduled"},
+		GameDate: time.Date(2024, time.July, 4, 23, 10, 0, 0, time.UTC),
+	}
+	if got := describeGameStatus(game, clock); got != "Starts @ 6:10 PM CDT" {
+		t.Fatalf("expected start time in the display zone, got %q", got)
+	}
+}
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss/v2"
+
+	"go.dalton.dog/batterup/internal/mlb"
+	"go.dalton.dog/batterup/internal/styles"
+)
+
+// alignmentForPlay returns the defense and offense blocks that apply to a play.
+// Plays carry their own blocks on recent feeds; otherwise the current linescore is
+// only trusted when the play being viewed is the latest one.
+func alignmentForPlay(play mlb.Play, linescore mlb.LiveLineScore, isLatest bool) (mlb.Defense, mlb.OffensiveState, bool) {
+	for i := len(play.PlayEvents) - 1; i >= 0; i-- {
+		event := play.PlayEvents[i]
+		if event.Defense != nil {
+			var offense mlb.OffensiveState
+			if event.Offense != nil {
+				offense = *event.Offense
+			}
+			return *event.Defense, offense, true
+		}
+	}
+	if isLatest {
+		return linescore.Defense, linescore.Offense, true
+	}
+	return mlb.Defense{}, mlb.OffensiveState{}, false
+}
+
+// renderFieldAlignment draws the nine defenders roughly where they stand on the field.
+func renderFieldAlignment(defense mlb.Defense, box mlb.Boxscore, teams mlb.GameTeams) string {
+	cell := func(position string, person *mlb.PersonRef) string {
+		return fieldCellStyle.Render(fieldPositionStyle.Render(position) + "\n" + fielderName(person, box, teams))
+	}
+	blank := fieldCellStyle.Render("")
+
+	rows := [][]string{
+		{blank, blank, cell("CF", defense.Center), blank, blank},
+		{cell("LF", defense.Left), blank, blank, blank, cell("RF", defense.Right)},
+		{blank, cell("SS", defense.Shortstop), blank, cell("2B", defense.Second), blank},
+		{cell("3B", defense.Third), blank, blank, blank, cell("1B", defense.First)},
+		{blank, blank, cell("P", defense.Pitcher), blank, blank},
+		{blank, blank, cell("C", defense.Catcher), blank, blank},
+	}
+
+	rendered := make([]string, 0, len(rows))
+	for _, row := range rows {
+		rendered = append(rendered, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, rendered...)
+}
+
+// renderDueUp lists the next two hitters after the current batter.
+func renderDueUp(offense mlb.OffensiveState, box mlb.Boxscore, teams mlb.GameTeams) string {
+	if offense.OnDeck == nil && offense.InHole == nil {
+		return ""
+	}
+	return fmt.Sprintf("On Deck: %s  •  In the Hole: %s",
+		fielderName(offense.OnDeck, box, teams),
+		fielderName(offense.InHole, box, teams),
+	)
+}
+
+// fielderName prefers the boxscore entry so jersey numbers are available,
+// falling back to the name embedded in the reference.
+func fielderName(person *mlb.PersonRef, box mlb.Boxscore, teams mlb.GameTeams) string {
+	if person == nil || person.ID == 0 {
+		return "—"
+	}
+	player, _ := lookupPlayer(person.ID, box, teams)
+	name := player.Person.FullName
+	if name == "" {
+		name = person.FullName
+	}
+	if player.JerseyNumber != "" {
+		return fmt.Sprintf("#%s %s", player.JerseyNumber, shortName(name))
+	}
+	return shortName(name)
+}
+
+func (g *GameModel) renderFieldScreen() string {
+	teams := g.feed.GameData.Teams
+	box := g.feed.LiveData.Boxscore
+
+	var play mlb.Play
+	if selected := g.currentPlayView(); selected != nil {
+		play = selected.play
+	} else {
+		play = g.feed.LiveData.Plays.CurrentPlay
+	}
+	isLatest := g.selectedPlay >= len(g.playViews)-1
+
+	defense, offense, ok := alignmentForPlay(play, g.feed.LiveData.Linescore, isLatest)
+	if !ok {
+		return lipgloss.JoinVertical(lipgloss.Center,
+			pitchMixEmptyStyle.Render("Fielding alignment is not available for this play"),
+			styles.HelpTextStyle.Render("f / esc to return to the game"),
+		)
+	}
+
+	fieldingTeam := teams.Home.Abbreviation
+	if !play.About.IsTopInning {
+		fieldingTeam = teams.Away.Abbreviation
+	}
+
+	parts := []string{
+		bullpenTitleStyle.Render(safeTeam(fieldingTeam) + " Defense"),
+		renderFieldAlignment(defense, box, teams),
+	}
+	if dueUp := renderDueUp(offense, box, teams); dueUp != "" {
+		parts = append(parts, dueUpStyle.Render(dueUp))
+	}
+	parts = append(parts, styles.HelpTextStyle.Render("f / esc to return to the game"))
+	return lipgloss.JoinVertical(lipgloss.Center, parts...)
+}
+
+var (
+	fieldCellStyle     = lipgloss.NewStyle().Width(18).Height(3).Align(lipgloss.Center)
+	fieldPositionStyle = lipgloss.NewStyle().Foreground(lipgloss.Yellow).Bold(true)
+	dueUpStyle         = lipgloss.NewStyle().Foreground(lipgloss.Cyan).Italic(true)
+)
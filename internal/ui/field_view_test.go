@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func TestAlignmentForPlayPrefersPlayBlocks(t *testing.T) {
+	playDefense := mlb.Defense{Catcher: &mlb.PersonRef{ID: 2}}
+	linescore := mlb.LiveLineScore{Defense: mlb.Defense{Catcher: &mlb.PersonRef{ID: 99}}}
+	play := mlb.Play{PlayEvents: []mlb.PlayEvent{
+		{Defense: &playDefense, Offense: &mlb.OffensiveState{OnDeck: &mlb.PersonRef{ID: 5}}},
+		{},
+	}}
+
+	defense, offense, ok := alignmentForPlay(play, linescore, false)
+	if !ok || defense.Catcher.ID != 2 || offense.OnDeck.ID != 5 {
+		t.Fatalf("expected play-level alignment, got %+v %+v", defense, offense)
+	}
+
+	defense, _, ok = alignmentForPlay(mlb.Play{}, linescore, true)
+	if !ok || defense.Catcher.ID != 99 {
+		t.Fatalf("expected linescore alignment for the latest play")
+	}
+
+	if _, _, ok = alignmentForPlay(mlb.Play{}, linescore, false); ok {
+		t.Fatalf("expected no alignment for historical plays without blocks")
+	}
+}
+
+func TestRenderFieldAlignmentListsPositions(t *testing.T) {
+	defense := mlb.Defense{
+		Pitcher:   &mlb.PersonRef{ID: 1, FullName: "Ace Pitcher"},
+		Shortstop: &mlb.PersonRef{ID: 6, FullName: "Slick Fielder"},
+	}
+	box := mlb.Boxscore{}
+	box.Teams.Home.Players = map[string]mlb.BoxscorePlayer{
+		"ID6": {Person: mlb.PersonInfo{FullName: "Slick Fielder"}, JerseyNumber: "2"},
+	}
+	out := renderFieldAlignment(defense, box, mlb.GameTeams{})
+	for _, want := range []string{"CF", "SS", "#2 S. Fielder", "A. Pitcher", "—"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in field view, got %q", want, out)
+		}
+	}
+}
+
+func TestRenderDueUp(t *testing.T) {
+	if got := renderDueUp(mlb.OffensiveState{}, mlb.Boxscore{}, mlb.GameTeams{}); got != "" {
+		t.Fatalf("expected nothing when due up is unknown, got %q", got)
+	}
+	offense := mlb.OffensiveState{
+		OnDeck: &mlb.PersonRef{ID: 3, FullName: "Next Hitter"},
+		InHole: &mlb.PersonRef{ID: 4, FullName: "Later Hitter"},
+	}
+	got := renderDueUp(offense, mlb.Boxscore{}, mlb.GameTeams{})
+	if !strings.Contains(got, "On Deck: N. Hitter") || !strings.Contains(got, "In the Hole: L. Hitter") {
+		t.Fatalf("unexpected due up line %q", got)
+	}
+}
@@ -12,8 +12,11 @@ import (
 
 // GameModel manages live game state and polling.
 type GameModel struct {
-	client  *mlb.Client
-	context context.Context
+	client   *mlb.Client
+	hub      *mlb.Hub
+	context  context.Context
+	clock    displayClock
+	requests requestScope
 
 	width  int
 	height int
@@ -23,8 +26,13 @@ type GameModel struct {
 	err     error
 	loading bool
 	active  bool
+	// status tracks the feed's freshness. A failed poll keeps the last good feed on screen.
+	status feedStatus
 
-	requestID int
+	// requestID identifies the current subscription so updates from earlier ones are dropped.
+	requestID    int
+	subscription *mlb.Subscription[*mlb.GameFeed]
+	screen       gameScreen
 
 	playViews       []playView
 	playLines       []playLine
@@ -33,26 +41,54 @@ type GameModel struct {
 	playsHeight     int
 	selectedPlay    int
 	selectedAtBat   int
+
+	baseAnim    *baseAnimation
+	baseAnimSeq int
+
+	gameLogs   map[int][]mlb.GameLogSplit
+	broadcasts []mlb.Broadcast
 }
 
+// gameScreen selects which layout the game view renders.
+type gameScreen int
+
+const (
+	screenLive gameScreen = iota
+	screenBullpen
+	screenField
+	screenInfo
+	// screenPlays shows a finished game's play-by-play instead of its recap.
+	screenPlays
+)
+
 type gameLoadedMsg struct {
-	id     int
-	gameID int
-	feed   *mlb.GameFeed
+	id       int
+	gameID   int
+	feed     *mlb.GameFeed
+	updated  time.Time
+	nextPoll time.Time
 }
 
 type gameFailedMsg struct {
-	id     int
-	gameID int
-	err    error
+	id       int
+	gameID   int
+	err      error
+	nextPoll time.Time
 }
 
-type gamePollMsg struct{}
+type gameLogLoadedMsg struct {
+	gameID    int
+	pitcherID int
+	splits    []mlb.GameLogSplit
+	err       error
+}
 
-func NewGameModel(client *mlb.Client, ctx context.Context) GameModel {
+func NewGameModel(client *mlb.Client, hub *mlb.Hub, ctx context.Context, clock displayClock) GameModel {
 	return GameModel{
 		client:  client,
+		hub:     hub,
 		context: ctx,
+		clock:   clock,
 	}
 }
 
@@ -75,6 +111,8 @@ func (g *GameModel) SetActive(active bool) {
 	g.active = active
 	if !active {
 		g.loading = false
+		g.unsubscribe()
+		g.requests.end()
 	}
 }
 
@@ -85,20 +123,38 @@ func (g GameModel) Update(msg tea.Msg) (GameModel, tea.Cmd) {
 			return g, nil
 		}
 		g.gameID = msg.GameID
+		g.broadcasts = msg.Broadcasts
 		g.feed = nil
 		g.err = nil
+		g.status = feedStatus{}
+		g.screen = screenLive
+		g.baseAnim = nil
+		g.gameLogs = make(map[int][]mlb.GameLogSplit)
 		g.resetPlayState()
 		g.loading = msg.GameID != 0
+		g.unsubscribe()
+		g.requests.end()
 		if g.gameID == 0 {
 			return g, nil
 		}
-		g.requestID++
-		return g, g.fetch()
+		return g, g.subscribe()
 	case tea.KeyMsg:
 		if !g.active {
 			return g, nil
 		}
 		switch msg.String() {
+		case "b":
+			g.toggleScreen(screenBullpen)
+		case "f":
+			g.toggleScreen(screenField)
+		case "i":
+			g.toggleScreen(screenInfo)
+		case "p":
+			if g.feed != nil && g.feed.GameData.Status.AbstractGameCode == "F" {
+				g.toggleScreen(screenPlays)
+			}
+		case "esc", "q":
+			g.screen = screenLive
 		case "g":
 			g.moveToStart()
 		case "G":
@@ -125,13 +181,36 @@ func (g GameModel) Update(msg tea.Msg) (GameModel, tea.Cmd) {
 		}
 		g.loading = false
 		g.err = nil
+		g.status.succeeded(msg.updated, msg.nextPoll)
+		prevPlay, hadPlays := g.latestPlayView()
 		g.feed = msg.feed
 		g.refreshViewport()
-		wait := msg.feed.MetaData.Wait
-		if wait == 0 {
-			wait = 10
+		cmds := []tea.Cmd{g.waitForUpdate()}
+		if cmd := g.animateBases(prevPlay, hadPlays); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+		cmds = append(cmds, g.fetchProbableGameLogs()...)
+		return g, tea.Batch(cmds...)
+	case gameLogLoadedMsg:
+		if msg.gameID != g.gameID || g.gameLogs == nil {
+			return g, nil
+		}
+		if msg.err != nil {
+			// Forget the request so the next poll tries again.
+			delete(g.gameLogs, msg.pitcherID)
+			return g, nil
+		}
+		g.gameLogs[msg.pitcherID] = msg.splits
+	case baseAnimationFrameMsg:
+		if msg.gameID != g.gameID || msg.seq != g.baseAnimSeq || g.baseAnim == nil {
+			return g, nil
+		}
+		g.baseAnim = g.baseAnim.next()
+		if g.baseAnim.done() {
+			g.baseAnim = nil
+			return g, nil
 		}
-		return g, tea.Tick(time.Duration(wait)*time.Second, func(time.Time) tea.Msg { return gamePollMsg{} })
+		return g, baseAnimationTick(g.gameID, g.baseAnimSeq)
 	case gameFailedMsg:
 		if msg.id != g.requestID || msg.gameID != g.gameID {
 			return g, nil
@@ -141,40 +220,113 @@ func (g GameModel) Update(msg tea.Msg) (GameModel, tea.Cmd) {
 		}
 		g.loading = false
 		g.err = msg.err
-	case gamePollMsg:
-		if !g.active || g.gameID == 0 {
-			return g, nil
-		}
-		g.requestID++
-		g.loading = true
-		return g, g.fetch()
+		g.status.failed(msg.nextPoll)
+		return g, g.waitForUpdate()
 	}
 	return g, nil
 }
 
-func (g GameModel) fetch() tea.Cmd {
-	if g.gameID == 0 || g.client == nil {
+// toggleScreen switches to the given screen, or back to the live layout if it is already shown.
+func (g *GameModel) toggleScreen(screen gameScreen) {
+	if g.screen == screen {
+		g.screen = screenLive
+		return
+	}
+	g.screen = screen
+}
+
+// InSubScreen reports whether a secondary screen is open, so back keys should close it
+// instead of leaving the game.
+func (g GameModel) InSubScreen() bool {
+	return g.screen != screenLive
+}
+
+// subscribe starts following the current game through the hub, which polls it
+// according to the game's state.
+func (g *GameModel) subscribe() tea.Cmd {
+	g.requestID++
+	if g.hub == nil {
 		return nil
 	}
+	g.subscription = g.hub.SubscribeGame(g.gameID)
+	return g.waitForUpdate()
+}
 
-	ctx := g.context
-	if ctx == nil {
-		ctx = context.Background()
+func (g *GameModel) unsubscribe() {
+	if g.subscription != nil {
+		g.subscription.Close()
+		g.subscription = nil
 	}
+}
 
+// waitForUpdate delivers the subscription's next update as a gameLoadedMsg or
+// gameFailedMsg. It returns nothing once the subscription is closed.
+func (g GameModel) waitForUpdate() tea.Cmd {
+	if g.subscription == nil {
+		return nil
+	}
+	updates := g.subscription.Updates()
 	requestID := g.requestID
 	gameID := g.gameID
-	client := g.client
-
 	return func() tea.Msg {
-		feed, err := client.FetchGame(ctx, gameID)
-		if err != nil {
-			return gameFailedMsg{id: requestID, gameID: gameID, err: err}
+		update, ok := <-updates
+		if !ok {
+			return nil
+		}
+		if update.Err != nil {
+			return gameFailedMsg{id: requestID, gameID: gameID, err: update.Err, nextPoll: update.NextPoll}
+		}
+		// Prefer the feed's own timestamp, which says how fresh the data is
+		// rather than when it was fetched.
+		updated, ok := update.Value.MetaData.Time()
+		if !ok {
+			updated = update.FetchedAt
 		}
-		return gameLoadedMsg{id: requestID, gameID: gameID, feed: feed}
+		return gameLoadedMsg{id: requestID, gameID: gameID, feed: update.Value, updated: updated, nextPoll: update.NextPoll}
 	}
 }
 
+// fetchProbableGameLogs requests recent starts for each probable pitcher of an
+// upcoming game. Each pitcher is only requested once per game, unless the
+// request fails.
+func (g *GameModel) fetchProbableGameLogs() []tea.Cmd {
+	if g.feed == nil || g.client == nil || g.gameLogs == nil || g.feed.GameData.Status.AbstractGameCode != "P" {
+		return nil
+	}
+
+	ctx := g.requests.current(g.context)
+
+	season := g.clock.Now().Year()
+	if t, err := time.Parse(time.RFC3339, g.feed.GameData.Datetime.DateTime); err == nil {
+		season = t.Year()
+	}
+
+	probables := g.feed.GameData.ProbablePitchers
+	var cmds []tea.Cmd
+	for _, probable := range []*mlb.PersonRef{probables.Away, probables.Home} {
+		if probable == nil || probable.ID == 0 {
+			continue
+		}
+		if _, requested := g.gameLogs[probable.ID]; requested {
+			continue
+		}
+		// Mark as requested so polls don't repeat the fetch while it's in flight.
+		g.gameLogs[probable.ID] = nil
+
+		client := g.client
+		gameID := g.gameID
+		pitcherID := probable.ID
+		cmds = append(cmds, func() tea.Msg {
+			splits, err := client.FetchPitchingGameLog(ctx, pitcherID, season)
+			if requestCancelled(err) {
+				return nil
+			}
+			return gameLogLoadedMsg{gameID: gameID, pitcherID: pitcherID, splits: splits, err: err}
+		})
+	}
+	return cmds
+}
+
 func (g GameModel) View() string {
 	if g.gameID == 0 {
 		return "Select a game to view"
@@ -182,16 +334,29 @@ func (g GameModel) View() string {
 	if g.loading && g.feed == nil {
 		return "Loading game…"
 	}
-	if g.err != nil {
+	if g.err != nil && g.feed == nil {
 		return lipgloss.NewStyle().Foreground(lipgloss.Color("red")).Render("Error loading game: " + g.err.Error())
 	}
 	if g.feed == nil {
 		return ""
 	}
 
+	switch g.screen {
+	case screenBullpen:
+		return renderBullpenUsage(buildPitcherUsage(g.feed.LiveData.Plays.AllPlays), g.feed.LiveData.Boxscore, g.feed.GameData.Teams)
+	case screenField:
+		return g.renderFieldScreen()
+	case screenInfo:
+		return renderInfoScreen(g.feed, g.clock.Now())
+	case screenPlays:
+		return g.renderLive()
+	}
+
 	switch g.feed.GameData.Status.AbstractGameCode {
 	case "P":
 		return g.renderPreview()
+	case "F":
+		return g.renderFinal()
 	default:
 		return g.renderLive()
 	}
@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/lipgloss/v2/table"
+
+	"go.dalton.dog/batterup/internal/mlb"
+	"go.dalton.dog/batterup/internal/styles"
+)
+
+// gameInfoSummary condenses weather, umpires, attendance and elapsed time into one line,
+// skipping anything the feed hasn't reported yet.
+func gameInfoSummary(feed *mlb.GameFeed, now time.Time) string {
+	var parts []string
+
+	if weather := formatWeather(feed.GameData.Weather); weather != "" {
+		parts = append(parts, weather)
+	}
+	if wind := feed.GameData.Weather.Wind; wind != "" {
+		parts = append(parts, "Wind "+wind)
+	}
+	if plate := homePlateUmpire(feed.LiveData.Boxscore.Officials); plate != "" {
+		parts = append(parts, "HP: "+plate)
+	}
+	if attendance := feed.GameData.GameInfo.Attendance; attendance > 0 {
+		parts = append(parts, "Att "+formatThousands(attendance))
+	}
+	if elapsed, ok := elapsedGameTime(feed.GameData.GameInfo, feed.GameData.Status, now); ok {
+		parts = append(parts, formatGameDuration(elapsed))
+	}
+
+	return strings.Join(parts, " • ")
+}
+
+// renderInfoScreen lists everything known about the game's conditions and crew.
+func renderInfoScreen(feed *mlb.GameFeed, now time.Time) string {
+	info := feed.GameData.GameInfo
+	weather := feed.GameData.Weather
+	venue := feed.GameData.Venue
+
+	tbl := table.New().Border(lipgloss.RoundedBorder())
+	addRow := func(label, value string) {
+		if value == "" {
+			value = "-"
+		}
+		tbl = tbl.Row(infoLabelStyle.Render(label), value)
+	}
+
+	var venueParts []string
+	for _, part := range []string{venue.Name, venue.Location.City, venue.Location.StateAbbrev} {
+		if part != "" {
+			venueParts = append(venueParts, part)
+		}
+	}
+	addRow("Venue", strings.Join(venueParts, ", "))
+	addRow("Weather", formatWeather(weather))
+	addRow("Wind", weather.Wind)
+
+	firstPitch := ""
+	if t, err := time.Parse(time.RFC3339, info.FirstPitch); err == nil {
+		firstPitch = t.In(now.Location()).Format("3:04 PM MST")
+	}
+	addRow("First Pitch", firstPitch)
+
+	duration := ""
+	if elapsed, ok := elapsedGameTime(info, feed.GameData.Status, now); ok {
+		duration = formatGameDuration(elapsed)
+	}
+	addRow("Game Time", duration)
+	if info.DelayDurationMinutes > 0 {
+		addRow("Delay", formatGameDuration(time.Duration(info.DelayDurationMinutes)*time.Minute))
+	}
+	attendance := ""
+	if info.Attendance > 0 {
+		attendance = formatThousands(info.Attendance)
+	}
+	addRow("Attendance", attendance)
+
+	crew := make([]string, 0, len(feed.LiveData.Boxscore.Officials))
+	for _, official := range feed.LiveData.Boxscore.Officials {
+		crew = append(crew, fmt.Sprintf("%s: %s", official.OfficialType, safeName(official.Official.FullName)))
+	}
+	addRow("Umpires", strings.Join(crew, "\n"))
+
+	return lipgloss.JoinVertical(lipgloss.Center,
+		bullpenTitleStyle.Render("Game Info"),
+		tbl.String(),
+		styles.HelpTextStyle.Render("i / esc to return to the game"),
+	)
+}
+
+func formatWeather(weather mlb.Weather) string {
+	switch {
+	case weather.Temp != "" && weather.Condition != "":
+		return fmt.Sprintf("%s° %s", weather.Temp, weather.Condition)
+	case weather.Temp != "":
+		return weather.Temp + "°"
+	default:
+		return weather.Condition
+	}
+}
+
+func homePlateUmpire(officials []mlb.Official) string {
+	for _, official := range officials {
+		if strings.EqualFold(official.OfficialType, "Home Plate") {
+			return official.Official.FullName
+		}
+	}
+	return ""
+}
+
+// elapsedGameTime prefers the official duration, and otherwise measures from
+// first pitch while the game is in progress.
+func elapsedGameTime(info mlb.GameInfo, status mlb.GameStatus, now time.Time) (time.Duration, bool) {
+	if info.GameDurationMinutes > 0 {
+		return time.Duration(info.GameDurationMinutes) * time.Minute, true
+	}
+	if status.AbstractGameCode != "L" {
+		return 0, false
+	}
+	start, err := time.Parse(time.RFC3339, info.FirstPitch)
+	if err != nil || now.Before(start) {
+		return 0, false
+	}
+	return now.Sub(start), true
+}
+
+// formatGameDuration renders durations the way box scores do, e.g. "2:47".
+func formatGameDuration(d time.Duration) string {
+	minutes := int(d / time.Minute)
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+}
+
+func formatThousands(n int) string {
+	digits := fmt.Sprintf("%d", n)
+	var b strings.Builder
+	for i, r := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+var infoLabelStyle = lipgloss.NewStyle().Foreground(lipgloss.Yellow).Bold(true)
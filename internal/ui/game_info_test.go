@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func TestGameInfoSummary(t *testing.T) {
+	feed := &mlb.GameFeed{}
+	feed.GameData.Status = mlb.GameStatus{AbstractGameCode: "L"}
+	feed.GameData.Weather = mlb.Weather{Condition: "Sunny", Temp: "72", Wind: "8 mph, Out To CF"}
+	feed.GameData.GameInfo = mlb.GameInfo{Attendance: 32145, FirstPitch: "2024-04-01T17:10:00Z"}
+	feed.LiveData.Boxscore.Officials = []mlb.Official{
+		{Official: mlb.PersonRef{FullName: "First Base Ump"}, OfficialType: "First Base"},
+		{Official: mlb.PersonRef{FullName: "Plate Ump"}, OfficialType: "Home Plate"},
+	}
+
+	now := time.Date(2024, time.April, 1, 19, 5, 0, 0, time.UTC)
+	got := gameInfoSummary(feed, now)
+	want := "72° Sunny • Wind 8 mph, Out To CF • HP: Plate Ump • Att 32,145 • 1:55"
+	if got != want {
+		t.Fatalf("gameInfoSummary() = %q, want %q", got, want)
+	}
+
+	if got := gameInfoSummary(&mlb.GameFeed{}, now); got != "" {
+		t.Fatalf("expected empty summary without data, got %q", got)
+	}
+}
+
+func TestElapsedGameTime(t *testing.T) {
+	now := time.Date(2024, time.April, 1, 20, 0, 0, 0, time.UTC)
+	info := mlb.GameInfo{FirstPitch: "2024-04-01T18:00:00Z"}
+
+	if _, ok := elapsedGameTime(info, mlb.GameStatus{AbstractGameCode: "P"}, now); ok {
+		t.Fatalf("expected no elapsed time before the game starts")
+	}
+	if got, ok := elapsedGameTime(info, mlb.GameStatus{AbstractGameCode: "L"}, now); !ok || got != 2*time.Hour {
+		t.Fatalf("expected two hours elapsed, got %v", got)
+	}
+	info.GameDurationMinutes = 167
+	if got, _ := elapsedGameTime(info, mlb.GameStatus{AbstractGameCode: "F"}, now); formatGameDuration(got) != "2:47" {
+		t.Fatalf("expected official duration, got %v", got)
+	}
+}
+
+func TestRenderInfoScreenListsCrew(t *testing.T) {
+	feed := &mlb.GameFeed{}
+	feed.LiveData.Boxscore.Officials = []mlb.Official{
+		{Official: mlb.PersonRef{FullName: "Plate Ump"}, OfficialType: "Home Plate"},
+	}
+	out := renderInfoScreen(feed, time.Now())
+	if !strings.Contains(out, "Home Plate: Plate Ump") || !strings.Contains(out, "Attendance") {
+		t.Fatalf("expected crew and attendance rows, got %q", out)
+	}
+}
+
+func TestFormatThousands(t *testing.T) {
+	cases := map[int]string{0: "0", 999: "999", 1000: "1,000", 45123: "45,123", 1234567: "1,234,567"}
+	for input, want := range cases {
+		if got := formatThousands(input); got != want {
+			t.Fatalf("formatThousands(%d) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"strings"
 
+	tea "github.com/charmbracelet/bubbletea/v2"
+
 	"go.dalton.dog/batterup/internal/mlb"
 )
 
 type playView struct {
 	play        mlb.Play
+	key         playKey
 	snapshot    playSnapshot
 	lines       []string
 	headerIndex int
@@ -25,6 +28,20 @@ type playSnapshot struct {
 	linescore mlb.LiveLineScore
 }
 
+// playKey identifies what a play looked like when its view was built. Plays
+// only grow between polls, so a matching key means the view is still current.
+// Completion is included because a play's result can be filled in without a
+// new pitch.
+type playKey struct {
+	atBat    int
+	events   int
+	complete bool
+}
+
+func keyForPlay(play mlb.Play) playKey {
+	return playKey{atBat: play.AtBatIndex, events: len(play.PlayEvents), complete: play.About.IsComplete}
+}
+
 func (g *GameModel) resetPlayState() {
 	g.playViews = nil
 	g.playLines = nil
@@ -46,8 +63,7 @@ func (g *GameModel) refreshViewport() {
 		g.resetPlayState()
 		return
 	}
-	snapshots := buildPlaySnapshots(plays)
-	g.playViews = buildPlayViews(plays, snapshots)
+	g.playViews = updatePlayViews(g.playViews, plays)
 	if len(g.playViews) == 0 {
 		g.resetPlayState()
 		return
@@ -202,6 +218,43 @@ func (g *GameModel) currentPlayView() *playView {
 	return &g.playViews[g.selectedPlay]
 }
 
+// latestPlayView returns the newest play and the bases after it, if any plays are loaded.
+func (g *GameModel) latestPlayView() (playView, bool) {
+	if len(g.playViews) == 0 {
+		return playView{}, false
+	}
+	return g.playViews[len(g.playViews)-1], true
+}
+
+// animateBases starts a runner animation when a refresh moved runners while the
+// newest play is in view. Initial loads never animate.
+func (g *GameModel) animateBases(prev playView, hadPlays bool) tea.Cmd {
+	if !hadPlays || len(g.playViews) == 0 || g.selectedPlay != len(g.playViews)-1 {
+		return nil
+	}
+	latest := g.playViews[len(g.playViews)-1]
+	anim := planBaseAnimation(prev.snapshot.linescore.Offense, latest.snapshot.linescore.Offense, prev.play, latest.play)
+	if anim == nil {
+		return nil
+	}
+	g.baseAnimSeq++
+	g.baseAnim = anim
+	return baseAnimationTick(g.gameID, g.baseAnimSeq)
+}
+
+// playsThroughSelected returns the plays up to and including the selected one,
+// so derived stats reflect the moment being viewed rather than the latest pitch.
+func (g *GameModel) playsThroughSelected() []mlb.Play {
+	if g.feed == nil {
+		return nil
+	}
+	plays := g.feed.LiveData.Plays.AllPlays
+	if g.selectedPlay < 0 || g.selectedPlay >= len(plays) {
+		return plays
+	}
+	return plays[:g.selectedPlay+1]
+}
+
 func (g *GameModel) enforcePlayOffset() {
 	if g.playsHeight <= 0 {
 		g.playsOffset = 0
@@ -241,6 +294,36 @@ func (g *GameModel) isFollowingLatest() bool {
 	return g.playsOffset >= g.maxPlaysOffset()
 }
 
+// updatePlayViews brings views built for an earlier poll up to date with plays.
+// Views are kept for the leading plays whose keys are unchanged; from the
+// first changed play on, the accumulator resumes from the last kept snapshot
+// and the rest are rebuilt.
+func updatePlayViews(prev []playView, plays []mlb.Play) []playView {
+	kept := 0
+	for kept < len(prev) && kept < len(plays) && prev[kept].key == keyForPlay(plays[kept]) {
+		kept++
+	}
+	if kept == len(prev) && kept == len(plays) {
+		return prev
+	}
+
+	views := make([]playView, kept, len(plays))
+	copy(views, prev[:kept])
+	acc := newGameAccumulator()
+	if kept > 0 {
+		acc = resumeGameAccumulator(prev[kept-1].snapshot)
+	}
+	for i := kept; i < len(plays); i++ {
+		acc.advance(plays[i])
+		var before *mlb.Play
+		if i > 0 {
+			before = &plays[i-1]
+		}
+		views = append(views, newPlayView(plays[i], acc.snapshot(plays[i]), before))
+	}
+	return views
+}
+
 func buildPlaySnapshots(plays []mlb.Play) []playSnapshot {
 	if len(plays) == 0 {
 		return nil
@@ -287,10 +370,44 @@ func newGameAccumulator() *gameAccumulator {
 	}
 }
 
+// resumeGameAccumulator rebuilds the accumulator's state as of a snapshot, so
+// accumulation can continue from that play without replaying the ones before.
+func resumeGameAccumulator(snapshot playSnapshot) *gameAccumulator {
+	linescore := snapshot.linescore
+	a := newGameAccumulator()
+	a.scoreAway, a.scoreHome = linescore.Teams.Away.Runs, linescore.Teams.Home.Runs
+	a.hitsAway, a.hitsHome = linescore.Teams.Away.Hits, linescore.Teams.Home.Hits
+	a.errorsAway, a.errorsHome = linescore.Teams.Away.Errors, linescore.Teams.Home.Errors
+	a.currentInning = linescore.CurrentInning
+	a.currentIsTop = linescore.IsTopInning
+	for base, runner := range map[string]*mlb.BaseRunner{
+		baseFirst:  linescore.Offense.First,
+		baseSecond: linescore.Offense.Second,
+		baseThird:  linescore.Offense.Third,
+	} {
+		if runner != nil {
+			a.bases[base] = runner.ID
+		}
+	}
+	for _, line := range linescore.Innings {
+		totals := &inningTotals{}
+		if line.Away.Runs != nil {
+			totals.awayRuns, totals.awayPlayed = *line.Away.Runs, true
+		}
+		if line.Home.Runs != nil {
+			totals.homeRuns, totals.homePlayed = *line.Home.Runs, true
+		}
+		a.innings[line.Num] = totals
+		a.maxInning = max(a.maxInning, line.Num)
+	}
+	return a
+}
+
 func (a *gameAccumulator) advance(play mlb.Play) {
 	a.ensureHalfInning(play)
 	a.applyRuns(play)
-	a.applyHitsAndErrors(play)
+	a.applyHits(play)
+	a.applyErrors(play)
 	a.applyRunners(play)
 }
 
@@ -305,13 +422,17 @@ func (a *gameAccumulator) ensureHalfInning(play mlb.Play) {
 	a.bases[baseThird] = 0
 }
 
+// applyRuns credits runs from runner movements that reach home safely. When a
+// play carries no runner detail, the change in the official score is used instead.
 func (a *gameAccumulator) applyRuns(play mlb.Play) {
-	prevAway, prevHome := a.scoreAway, a.scoreHome
-	a.scoreAway = play.Result.AwayScore
-	a.scoreHome = play.Result.HomeScore
-
-	deltaAway := max(a.scoreAway-prevAway, 0)
-	deltaHome := max(a.scoreHome-prevHome, 0)
+	runs := scoredRunners(play)
+	if runs == 0 {
+		if play.About.IsTopInning {
+			runs = max(play.Result.AwayScore-a.scoreAway, 0)
+		} else {
+			runs = max(play.Result.HomeScore-a.scoreHome, 0)
+		}
+	}
 
 	inning := play.About.Inning
 	totals, ok := a.innings[inning]
@@ -320,10 +441,12 @@ func (a *gameAccumulator) applyRuns(play mlb.Play) {
 		a.innings[inning] = totals
 	}
 	if play.About.IsTopInning {
-		totals.awayRuns += deltaAway
+		a.scoreAway += runs
+		totals.awayRuns += runs
 		totals.awayPlayed = true
 	} else {
-		totals.homeRuns += deltaHome
+		a.scoreHome += runs
+		totals.homeRuns += runs
 		totals.homePlayed = true
 	}
 	if inning > a.maxInning {
@@ -331,25 +454,83 @@ func (a *gameAccumulator) applyRuns(play mlb.Play) {
 	}
 }
 
-func (a *gameAccumulator) applyHitsAndErrors(play mlb.Play) {
+// applyHits credits the batting club when the batter's official result is a hit.
+func (a *gameAccumulator) applyHits(play mlb.Play) {
 	eventType := strings.ToLower(play.Result.EventType)
 	if eventType == "" {
 		eventType = strings.ToLower(play.Result.Event)
 	}
-	if isHitEvent(eventType) {
-		if play.About.IsTopInning {
-			a.hitsAway++
-		} else {
-			a.hitsHome++
+	if !isHitEvent(eventType) {
+		return
+	}
+	if play.About.IsTopInning {
+		a.hitsAway++
+	} else {
+		a.hitsHome++
+	}
+}
+
+// applyErrors charges errors to the fielding club, which is the home team in the top half.
+func (a *gameAccumulator) applyErrors(play mlb.Play) {
+	errors := playErrors(play)
+	if play.About.IsTopInning {
+		a.errorsHome += errors
+	} else {
+		a.errorsAway += errors
+	}
+}
+
+// scoredRunners counts runners whose movement ends safely at home.
+func scoredRunners(play mlb.Play) int {
+	runs := 0
+	for _, runner := range play.Runners {
+		if runner.Movement.IsOut {
+			continue
+		}
+		if isHomePlate(runner.Movement.End) {
+			runs++
 		}
 	}
-	if isErrorEvent(eventType) {
-		if play.About.IsTopInning {
-			a.errorsHome++
-		} else {
-			a.errorsAway++
+	return runs
+}
+
+// playErrors counts the errors charged on a play. Fielding credits are authoritative;
+// runner event types and then the play's own event type are used when credits are missing.
+func playErrors(play mlb.Play) int {
+	credited := make(map[string]struct{})
+	for _, runner := range play.Runners {
+		for _, credit := range runner.Credits {
+			if !isErrorEvent(strings.ToLower(credit.Credit)) {
+				continue
+			}
+			key := fmt.Sprintf("%d/%d/%s", runner.Details.PlayIndex, credit.Player.ID, credit.Credit)
+			credited[key] = struct{}{}
 		}
 	}
+	if len(credited) > 0 {
+		return len(credited)
+	}
+
+	for _, runner := range play.Runners {
+		eventType := strings.ToLower(runner.Details.EventType)
+		if !isErrorEvent(eventType) {
+			continue
+		}
+		key := fmt.Sprintf("%d/%s", runner.Details.PlayIndex, eventType)
+		credited[key] = struct{}{}
+	}
+	if len(credited) > 0 {
+		return len(credited)
+	}
+
+	eventType := strings.ToLower(play.Result.EventType)
+	if eventType == "" {
+		eventType = strings.ToLower(play.Result.Event)
+	}
+	if isErrorEvent(eventType) {
+		return 1
+	}
+	return 0
 }
 
 func (a *gameAccumulator) applyRunners(play mlb.Play) {
@@ -431,6 +612,14 @@ const (
 	baseThird  = "3B"
 )
 
+func isHomePlate(value string) bool {
+	switch strings.ToLower(value) {
+	case "score", "home", "hp":
+		return true
+	}
+	return false
+}
+
 func normalizeBase(value string) string {
 	switch strings.ToUpper(value) {
 	case baseFirst:
@@ -1,6 +1,12 @@
 package ui
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"go.dalton.dog/batterup/internal/mlb"
@@ -81,6 +87,82 @@ func TestBuildPlaySnapshotsAccumulatesRunsHitsAndBases(t *testing.T) {
 	}
 }
 
+func TestBuildPlaySnapshotsChargesErrorsFromCredits(t *testing.T) {
+	plays := []mlb.Play{
+		{
+			Result: mlb.PlayResult{EventType: "single", AwayScore: 1},
+			About:  mlb.PlayAbout{Inning: 1, HalfInning: "top", IsTopInning: true},
+			Runners: []mlb.PlayRunner{
+				{
+					Movement: mlb.RunnerMovement{Start: "2B", End: "score"},
+					Details:  mlb.RunnerDetails{Runner: mlb.RunnerInfo{ID: 7}, EventType: "single", PlayIndex: 3},
+				},
+				{
+					Movement: mlb.RunnerMovement{End: "1B"},
+					Details:  mlb.RunnerDetails{Runner: mlb.RunnerInfo{ID: 8}, EventType: "single", PlayIndex: 3},
+				},
+				{
+					Movement: mlb.RunnerMovement{Start: "1B", End: "2B"},
+					Details:  mlb.RunnerDetails{Runner: mlb.RunnerInfo{ID: 8}, EventType: "single", PlayIndex: 3},
+					Credits: []mlb.RunnerCredit{
+						{Player: mlb.PersonRef{ID: 55}, Credit: "f_throwing_error"},
+					},
+				},
+			},
+		},
+		{
+			Result: mlb.PlayResult{EventType: "strikeout", AwayScore: 1},
+			About:  mlb.PlayAbout{Inning: 1, HalfInning: "bottom", IsTopInning: false},
+			Runners: []mlb.PlayRunner{
+				{
+					Movement: mlb.RunnerMovement{Start: "1B", End: "2B"},
+					Details:  mlb.RunnerDetails{Runner: mlb.RunnerInfo{ID: 9}, EventType: "pickoff_error_1b", PlayIndex: 1},
+				},
+			},
+		},
+	}
+
+	snapshots := buildPlaySnapshots(plays)
+
+	first := snapshots[0].linescore
+	if first.Teams.Away.Runs != 1 || first.Teams.Away.Hits != 1 {
+		t.Fatalf("expected 1 run and 1 hit for away, got %+v", first.Teams.Away)
+	}
+	if first.Teams.Home.Errors != 1 || first.Teams.Away.Errors != 0 {
+		t.Fatalf("expected throwing error charged to home club, got away %d home %d", first.Teams.Away.Errors, first.Teams.Home.Errors)
+	}
+	if first.Offense.Second == nil || first.Offense.Second.ID != 8 {
+		t.Fatalf("expected batter to take second on the error")
+	}
+
+	second := snapshots[1].linescore
+	if second.Teams.Away.Errors != 1 {
+		t.Fatalf("expected pickoff error charged to away club, got %d", second.Teams.Away.Errors)
+	}
+	if second.Teams.Home.Hits != 0 {
+		t.Fatalf("expected no home hits on a strikeout, got %d", second.Teams.Home.Hits)
+	}
+}
+
+func TestPlayErrorsDeduplicatesCredits(t *testing.T) {
+	credit := mlb.RunnerCredit{Player: mlb.PersonRef{ID: 4}, Credit: "f_fielding_error"}
+	play := mlb.Play{
+		Result: mlb.PlayResult{EventType: "field_error"},
+		Runners: []mlb.PlayRunner{
+			{Details: mlb.RunnerDetails{PlayIndex: 2}, Credits: []mlb.RunnerCredit{credit, {Credit: "f_assist"}}},
+			{Details: mlb.RunnerDetails{PlayIndex: 2}, Credits: []mlb.RunnerCredit{credit}},
+		},
+	}
+	if got := playErrors(play); got != 1 {
+		t.Fatalf("expected a single error, got %d", got)
+	}
+
+	play.Runners = nil
+	if got := playErrors(play); got != 1 {
+		t.Fatalf("expected event type fallback to count one error, got %d", got)
+	}
+}
+
 func TestBuildPlayViewsOrdersAscending(t *testing.T) {
 	plays := []mlb.Play{
 		{
@@ -410,3 +492,207 @@ func TestRefreshViewportKeepsPositionWhenNotFollowing(t *testing.T) {
 		t.Fatalf("expected selection to remain on atBat 1, got atBat %d index %d", gm.selectedAtBat, gm.selectedPlay)
 	}
 }
+
+// longGamePlays builds a complete extra-inning game with a few pitches per
+// plate appearance, singles, outs and the odd home run, for checking the
+// incremental rebuild against edits a recorded feed can't show.
+func longGamePlays(innings int) []mlb.Play {
+	var plays []mlb.Play
+	var awayScore, homeScore int
+	for inning := 1; inning <= innings; inning++ {
+		for _, top := range []bool{true, false} {
+			half := "bottom"
+			if top {
+				half = "top"
+			}
+			onFirst := 0
+			for batter := range 6 {
+				atBat := len(plays)
+				batterID := 1000 + atBat
+				play := mlb.Play{
+					AtBatIndex: atBat,
+					About:      mlb.PlayAbout{Inning: inning, HalfInning: half, IsTopInning: top, IsComplete: true},
+					Count:      mlb.PlayCount{Outs: min(batter/2, 2)},
+				}
+				for pitch, desc := range []string{"Ball", "Called Strike", "Foul", "Ball", "In play"} {
+					play.PlayEvents = append(play.PlayEvents, mlb.PlayEvent{
+						IsPitch: true,
+						Details: mlb.PlayEventDetails{Description: fmt.Sprintf("%s (%d)", desc, pitch+1), IsBall: desc == "Ball", IsStrike: desc != "Ball" && desc != "In play", IsInPlay: desc == "In play"},
+					})
+				}
+				switch batter % 3 {
+				case 0:
+					play.Result = mlb.PlayResult{Event: "Single", EventType: "single"}
+					play.Runners = []mlb.PlayRunner{{Movement: mlb.RunnerMovement{End: "1B"}, Details: mlb.RunnerDetails{Runner: mlb.RunnerInfo{ID: batterID}}}}
+					onFirst = batterID
+				case 1:
+					play.Result = mlb.PlayResult{Event: "Groundout", EventType: "field_out", IsOut: true}
+					play.About.HasOut = true
+					play.Runners = []mlb.PlayRunner{{Movement: mlb.RunnerMovement{IsOut: true}, Details: mlb.RunnerDetails{Runner: mlb.RunnerInfo{ID: batterID}}}}
+				default:
+					if inning%4 == 0 && onFirst != 0 {
+						play.Result = mlb.PlayResult{Event: "Home Run", EventType: "home_run"}
+						play.About.IsScoringPlay = true
+						play.Runners = []mlb.PlayRunner{
+							{Movement: mlb.RunnerMovement{Start: "1B", End: "score"}, Details: mlb.RunnerDetails{Runner: mlb.RunnerInfo{ID: onFirst}}},
+							{Movement: mlb.RunnerMovement{End: "score"}, Details: mlb.RunnerDetails{Runner: mlb.RunnerInfo{ID: batterID}}},
+						}
+						if top {
+							awayScore += 2
+						} else {
+							homeScore += 2
+						}
+					} else {
+						play.Result = mlb.PlayResult{Event: "Flyout", EventType: "field_out", IsOut: true}
+						play.About.HasOut = true
+					}
+					onFirst = 0
+				}
+				play.Result.AwayScore, play.Result.HomeScore = awayScore, homeScore
+				plays = append(plays, play)
+			}
+		}
+	}
+	return plays
+}
+
+func TestUpdatePlayViewsMatchesFullRebuild(t *testing.T) {
+	plays := longGamePlays(12)
+	full := func(plays []mlb.Play) []playView {
+		return buildPlayViews(plays, buildPlaySnapshots(plays))
+	}
+
+	// The latest play is in progress with two pitches seen so far.
+	inProgress := append([]mlb.Play(nil), plays...)
+	last := &inProgress[len(inProgress)-1]
+	last.PlayEvents = last.PlayEvents[:2]
+	last.About.IsComplete = false
+	last.Result = mlb.PlayResult{AwayScore: last.Result.AwayScore, HomeScore: last.Result.HomeScore}
+	last.Runners = nil
+	prev := full(inProgress[:len(inProgress)-10])
+
+	// A review adds an event to a play long since finished.
+	revised := append([]mlb.Play(nil), plays...)
+	revised[40].PlayEvents = append(append([]mlb.PlayEvent(nil), plays[40].PlayEvents...), mlb.PlayEvent{Details: mlb.PlayEventDetails{Description: "Call overturned"}})
+
+	for _, step := range []struct {
+		name  string
+		plays []mlb.Play
+	}{
+		{"new plays", inProgress},
+		{"play completed", plays},
+		{"same plays", plays},
+		{"earlier play revised", revised},
+		{"plays removed", plays[:50]},
+	} {
+		got := updatePlayViews(prev, step.plays)
+		if want := full(step.plays); !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: expected incremental views to match a full rebuild", step.name)
+		}
+		prev = got
+	}
+}
+
+func TestUpdatePlayViewsReusesUnchangedPlays(t *testing.T) {
+	plays := longGamePlays(3)
+	prev := updatePlayViews(nil, plays[:len(plays)-1])
+	views := updatePlayViews(prev, plays)
+	if &views[0].lines[0] != &prev[0].lines[0] {
+		t.Fatalf("expected unchanged plays to keep their rendered lines")
+	}
+	if len(views) != len(plays) {
+		t.Fatalf("expected %d views, got %d", len(plays), len(views))
+	}
+}
+
+// recordedFeeds loads the live feeds recorded under testdata. Until one is
+// recorded it stands in a synthetic 15-inning game, so the tests and
+// benchmarks built on it always run. See testdata/README.md for how to add one.
+func recordedFeeds(tb testing.TB) map[string]*mlb.GameFeed {
+	tb.Helper()
+	paths, err := filepath.Glob(filepath.Join("testdata", "*.json"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if len(paths) == 0 {
+		tb.Log("no recorded feeds in testdata; using a synthetic 15-inning game")
+		feed := &mlb.GameFeed{}
+		feed.LiveData.Plays.AllPlays = longGamePlays(15)
+		return map[string]*mlb.GameFeed{"synthetic": feed}
+	}
+	feeds := make(map[string]*mlb.GameFeed, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		var feed mlb.GameFeed
+		if err := json.Unmarshal(data, &feed); err != nil {
+			tb.Fatalf("%s: %v", path, err)
+		}
+		feeds[strings.TrimSuffix(filepath.Base(path), ".json")] = &feed
+	}
+	return feeds
+}
+
+// replayPolls cuts a finished game into the sequence of AllPlays a poller
+// would have seen, one pitch at a time.
+func replayPolls(plays []mlb.Play) [][]mlb.Play {
+	var polls [][]mlb.Play
+	for i, play := range plays {
+		for events := 1; events <= len(play.PlayEvents); events++ {
+			partial := play
+			partial.PlayEvents = play.PlayEvents[:events]
+			partial.About.IsComplete = play.About.IsComplete && events == len(play.PlayEvents)
+			poll := append(append([]mlb.Play(nil), plays[:i]...), partial)
+			polls = append(polls, poll)
+		}
+	}
+	return polls
+}
+
+func TestUpdatePlayViewsMatchesFullRebuildOnRecordedFeeds(t *testing.T) {
+	for name, feed := range recordedFeeds(t) {
+		t.Run(name, func(t *testing.T) {
+			var views []playView
+			for _, plays := range replayPolls(feed.LiveData.Plays.AllPlays) {
+				views = updatePlayViews(views, plays)
+				if want := buildPlayViews(plays, buildPlaySnapshots(plays)); !reflect.DeepEqual(views, want) {
+					t.Fatalf("after at-bat %d: expected incremental views to match a full rebuild", plays[len(plays)-1].AtBatIndex)
+				}
+			}
+		})
+	}
+}
+
+// benchmarkPlayViews measures refreshing the views of each recorded game after
+// its final pitch arrives, either rebuilding everything or updating
+// incrementally from the views of the poll before.
+func benchmarkPlayViews(b *testing.B, refresh func(prev []playView, plays []mlb.Play) []playView) {
+	for name, feed := range recordedFeeds(b) {
+		b.Run(name, func(b *testing.B) {
+			plays := feed.LiveData.Plays.AllPlays
+			polls := replayPolls(plays)
+			if len(polls) < 2 {
+				b.Skip("feed has too few pitches to replay")
+			}
+			prev := updatePlayViews(nil, polls[len(polls)-2])
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				refresh(prev, plays)
+			}
+		})
+	}
+}
+
+func BenchmarkPlayViewsFullRebuild(b *testing.B) {
+	benchmarkPlayViews(b, func(_ []playView, plays []mlb.Play) []playView {
+		return buildPlayViews(plays, buildPlaySnapshots(plays))
+	})
+}
+
+func BenchmarkPlayViewsIncremental(b *testing.B) {
+	benchmarkPlayViews(b, updatePlayViews)
+}
@@ -0,0 +1,245 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss/v2"
+
+	"go.dalton.dog/batterup/internal/mlb"
+	"go.dalton.dog/batterup/internal/styles"
+)
+
+// Region: Final Recap
+
+// performer is one player's line in the recap's top performers list.
+type performer struct {
+	name  string
+	team  string
+	line  string
+	score int
+}
+
+const (
+	recapHitterCount  = 3
+	recapPitcherCount = 2
+)
+
+// renderFinal recaps a finished game: the line score, pitchers of record, the
+// best individual lines, every scoring play and the game's length and crowd.
+func (g *GameModel) renderFinal() string {
+	feed := g.feed
+	teams := feed.GameData.Teams
+	box := feed.LiveData.Boxscore
+	linescore := feed.LiveData.Linescore
+
+	title := recapTitleStyle.Render(fmt.Sprintf("%s %d • %s %d — %s",
+		safeTeam(teams.Away.Abbreviation), linescore.Teams.Away.Runs,
+		safeTeam(teams.Home.Abbreviation), linescore.Teams.Home.Runs,
+		feed.GameData.Status.DetailedState))
+
+	decisions := renderDecisions(feed.LiveData.Decisions, box, teams)
+	var performers []string
+	for _, p := range append(topHitters(box, teams, recapHitterCount), topPitchers(box, teams, recapPitcherCount)...) {
+		performers = append(performers, fmt.Sprintf("%s (%s) %s", p.name, p.team, p.line))
+	}
+	details := lipgloss.JoinHorizontal(lipgloss.Top,
+		recapColumnStyle.Render(lipgloss.JoinVertical(lipgloss.Left, append([]string{bullpenTitleStyle.Render("Decisions")}, decisions...)...)),
+		recapColumnStyle.Render(lipgloss.JoinVertical(lipgloss.Left, append([]string{bullpenTitleStyle.Render("Top Performers")}, performers...)...)),
+	)
+
+	var facts []string
+	if minutes := feed.GameData.GameInfo.GameDurationMinutes; minutes > 0 {
+		facts = append(facts, "Time "+formatGameDuration(time.Duration(minutes)*time.Minute))
+	}
+	if attendance := feed.GameData.GameInfo.Attendance; attendance > 0 {
+		facts = append(facts, "Att "+formatThousands(attendance))
+	}
+
+	help := styles.HelpTextStyle.Render("p for play-by-play • b bullpen • f field • i info • esc to return")
+	top := []string{title, renderLineScoreTable(linescore, teams), details}
+	if len(facts) > 0 {
+		top = append(top, strings.Join(facts, " • "))
+	}
+
+	// Scoring plays get whatever room is left so the help line stays on screen.
+	used := lipgloss.Height(lipgloss.JoinVertical(lipgloss.Center, top...)) + lipgloss.Height(help) + 1
+	scoring := renderScoringPlays(feed.LiveData.Plays.AllPlays, teams, g.width-4, g.height-used)
+
+	parts := append(top, scoring, help)
+	return lipgloss.JoinVertical(lipgloss.Center, parts...)
+}
+
+// renderDecisions lists the winning, losing and saving pitchers with their
+// season records, which already include this game.
+func renderDecisions(decisions *mlb.Decisions, box mlb.Boxscore, teams mlb.GameTeams) []string {
+	if decisions == nil {
+		return []string{emptyBasesStyle.Render("No decisions")}
+	}
+	var lines []string
+	for _, decision := range []struct {
+		label  string
+		person *mlb.PersonRef
+	}{
+		{"W", decisions.Winner},
+		{"L", decisions.Loser},
+		{"S", decisions.Save},
+	} {
+		if decision.person == nil {
+			continue
+		}
+		player, _ := lookupPlayer(decision.person.ID, box, teams)
+		name := player.Person.FullName
+		if name == "" {
+			name = decision.person.FullName
+		}
+		season := player.SeasonStats.Pitching
+		record := fmt.Sprintf("(%d-%d, %s)", season.Wins, season.Losses, statOrDash(season.ERA))
+		if decision.label == "S" {
+			record = fmt.Sprintf("(%d)", season.Saves)
+		}
+		lines = append(lines, fmt.Sprintf("%s  %s %s", recapDecisionStyle.Render(decision.label), shortName(safeName(name)), record))
+	}
+	return lines
+}
+
+// topHitters ranks every batter by a simple weighting of hits, power, runs
+// driven in and scored, and walks.
+func topHitters(box mlb.Boxscore, teams mlb.GameTeams, limit int) []performer {
+	return rankPerformers(box, teams, limit, func(player mlb.BoxscorePlayer) (string, int, bool) {
+		batting := player.Stats.Batting
+		if batting.AtBats == 0 && batting.BaseOnBalls == 0 {
+			return "", 0, false
+		}
+		score := 2*batting.Hits + 3*batting.HomeRuns + 2*batting.RBI + batting.Runs + batting.BaseOnBalls
+		return describeBatting(batting), score, score > 0
+	})
+}
+
+// topPitchers ranks every pitcher by outs recorded and strikeouts, less the
+// hits, walks and earned runs allowed.
+func topPitchers(box mlb.Boxscore, teams mlb.GameTeams, limit int) []performer {
+	return rankPerformers(box, teams, limit, func(player mlb.BoxscorePlayer) (string, int, bool) {
+		pitching := player.Stats.Pitching
+		if pitching.InningsPitched == "" {
+			return "", 0, false
+		}
+		score := inningsToOuts(pitching.InningsPitched) + 2*pitching.StrikeOuts - pitching.Hits - pitching.BaseOnBalls - 3*pitching.EarnedRuns
+		line := fmt.Sprintf("%s IP, %d H, %d ER, %d BB, %d K",
+			pitching.InningsPitched, pitching.Hits, pitching.EarnedRuns, pitching.BaseOnBalls, pitching.StrikeOuts)
+		return line, score, true
+	})
+}
+
+func rankPerformers(box mlb.Boxscore, teams mlb.GameTeams, limit int, rate func(mlb.BoxscorePlayer) (string, int, bool)) []performer {
+	var ranked []performer
+	for _, side := range []struct {
+		abbrev  string
+		players map[string]mlb.BoxscorePlayer
+	}{
+		{teams.Away.Abbreviation, box.Teams.Away.Players},
+		{teams.Home.Abbreviation, box.Teams.Home.Players},
+	} {
+		for _, player := range side.players {
+			line, score, ok := rate(player)
+			if !ok {
+				continue
+			}
+			ranked = append(ranked, performer{
+				name:  shortName(player.Person.FullName),
+				team:  safeTeam(side.abbrev),
+				line:  line,
+				score: score,
+			})
+		}
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].name < ranked[j].name
+	})
+	return ranked[:min(limit, len(ranked))]
+}
+
+// describeBatting renders a box score line such as "3-4, 2 HR, 4 RBI".
+func describeBatting(batting mlb.BattingStats) string {
+	parts := []string{fmt.Sprintf("%d-%d", batting.Hits, batting.AtBats)}
+	for _, stat := range []struct {
+		count int
+		label string
+	}{
+		{batting.HomeRuns, "HR"},
+		{batting.RBI, "RBI"},
+		{batting.Runs, "R"},
+		{batting.BaseOnBalls, "BB"},
+	} {
+		switch {
+		case stat.count == 1:
+			parts = append(parts, stat.label)
+		case stat.count > 1:
+			parts = append(parts, fmt.Sprintf("%d %s", stat.count, stat.label))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// inningsToOuts converts innings pitched as the API writes them, where "6.2"
+// means six innings and two outs.
+func inningsToOuts(innings string) int {
+	whole, partial, _ := strings.Cut(innings, ".")
+	full, err := strconv.Atoi(whole)
+	if err != nil {
+		return 0
+	}
+	outs, _ := strconv.Atoi(partial)
+	return full*3 + outs
+}
+
+// renderScoringPlays lists each run-scoring play with the score after it. When
+// there are more than fit in maxLines, the latest plays are kept. Non-positive
+// limits are ignored.
+func renderScoringPlays(plays []mlb.Play, teams mlb.GameTeams, maxWidth, maxLines int) string {
+	var lines []string
+	for _, play := range plays {
+		if !play.About.IsScoringPlay {
+			continue
+		}
+		half := "Bot"
+		if play.About.IsTopInning {
+			half = "Top"
+		}
+		score := fmt.Sprintf("%s %d, %s %d",
+			safeTeam(teams.Away.Abbreviation), play.Result.AwayScore,
+			safeTeam(teams.Home.Abbreviation), play.Result.HomeScore)
+		line := fmt.Sprintf("%s %s • %s • %s", half, ordinal(play.About.Inning), score, play.Result.Description)
+		if maxWidth > 0 {
+			line = truncateText(line, maxWidth)
+		}
+		lines = append(lines, line)
+	}
+
+	title := bullpenTitleStyle.Render("Scoring Plays")
+	if len(lines) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, title, emptyBasesStyle.Render("No runs scored"))
+	}
+	// Leave room for the title, its margin and the note about hidden plays.
+	if room := maxLines - 3; maxLines > 0 && len(lines) > room {
+		room = max(room, 1)
+		hidden := len(lines) - room
+		lines = append([]string{emptyBasesStyle.Render(fmt.Sprintf("%d earlier scoring plays • p for play-by-play", hidden))}, lines[hidden:]...)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, append([]string{title}, lines...)...)
+}
+
+var (
+	recapTitleStyle    = lipgloss.NewStyle().Bold(true).PaddingTop(1)
+	recapColumnStyle   = lipgloss.NewStyle().Padding(0, 2)
+	recapDecisionStyle = lipgloss.NewStyle().Foreground(lipgloss.Yellow).Bold(true)
+)
+
+// End Region: Final Recap
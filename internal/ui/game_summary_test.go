@@ -0,0 +1,126 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func recapFeed() *mlb.GameFeed {
+	feed := &mlb.GameFeed{}
+	feed.GameData.Status = mlb.GameStatus{AbstractGameCode: "F", DetailedState: "Final"}
+	feed.GameData.Teams = mlb.GameTeams{
+		Away: mlb.GameTeam{Abbreviation: "NYY"},
+		Home: mlb.GameTeam{Abbreviation: "SEA"},
+	}
+	feed.GameData.GameInfo = mlb.GameInfo{Attendance: 45123, GameDurationMinutes: 167}
+	feed.LiveData.Linescore.Teams.Away.Runs = 1
+	feed.LiveData.Linescore.Teams.Home.Runs = 4
+
+	feed.LiveData.Boxscore.Teams.Away.Players = map[string]mlb.BoxscorePlayer{
+		"ID1": {
+			Person:      mlb.PersonInfo{FullName: "Gerrit Cole"},
+			Stats:       mlb.BoxscorePlayerStats{Pitching: mlb.PitchingStats{InningsPitched: "5.1", Hits: 7, EarnedRuns: 4, StrikeOuts: 5}},
+			SeasonStats: mlb.BoxscorePlayerSeason{Pitching: mlb.SeasonPitching{Wins: 12, Losses: 5, ERA: "3.20"}},
+		},
+		"ID2": {
+			Person: mlb.PersonInfo{FullName: "Aaron Judge"},
+			Stats:  mlb.BoxscorePlayerStats{Batting: mlb.BattingStats{Hits: 1, AtBats: 4, HomeRuns: 1, RBI: 1, Runs: 1}},
+		},
+	}
+	feed.LiveData.Boxscore.Teams.Home.Players = map[string]mlb.BoxscorePlayer{
+		"ID3": {
+			Person:      mlb.PersonInfo{FullName: "George Kirby"},
+			Stats:       mlb.BoxscorePlayerStats{Pitching: mlb.PitchingStats{InningsPitched: "8.0", Hits: 4, EarnedRuns: 1, StrikeOuts: 9}},
+			SeasonStats: mlb.BoxscorePlayerSeason{Pitching: mlb.SeasonPitching{Wins: 13, Losses: 8, ERA: "3.35"}},
+		},
+		"ID4": {
+			Person: mlb.PersonInfo{FullName: "Julio Rodriguez"},
+			Stats:  mlb.BoxscorePlayerStats{Batting: mlb.BattingStats{Hits: 3, AtBats: 4, HomeRuns: 2, RBI: 3, Runs: 2}},
+		},
+		"ID5": {
+			Person:      mlb.PersonInfo{FullName: "Andres Munoz"},
+			Stats:       mlb.BoxscorePlayerStats{Pitching: mlb.PitchingStats{InningsPitched: "1.0", StrikeOuts: 2}},
+			SeasonStats: mlb.BoxscorePlayerSeason{Pitching: mlb.SeasonPitching{Saves: 22}},
+		},
+	}
+	feed.LiveData.Decisions = &mlb.Decisions{
+		Winner: &mlb.PersonRef{ID: 3},
+		Loser:  &mlb.PersonRef{ID: 1},
+		Save:   &mlb.PersonRef{ID: 5},
+	}
+	feed.LiveData.Plays.AllPlays = []mlb.Play{
+		{About: mlb.PlayAbout{Inning: 1, IsTopInning: true, IsScoringPlay: true}, Result: mlb.PlayResult{Description: "Aaron Judge homers.", AwayScore: 1}},
+		{About: mlb.PlayAbout{Inning: 1}, Result: mlb.PlayResult{Description: "Julio Rodriguez strikes out."}},
+		{About: mlb.PlayAbout{Inning: 4, IsScoringPlay: true}, Result: mlb.PlayResult{Description: "Julio Rodriguez homers.", AwayScore: 1, HomeScore: 4}},
+	}
+	return feed
+}
+
+func TestRenderFinalRecap(t *testing.T) {
+	gm := GameModel{gameID: 1, feed: recapFeed(), width: 120, height: 60, active: true}
+
+	view := gm.View()
+	for _, want := range []string{
+		"NYY 1 • SEA 4 — Final",
+		"G. Kirby (13-8, 3.35)",
+		"G. Cole (12-5, 3.20)",
+		"A. Munoz (22)",
+		"J. Rodriguez (SEA) 3-4, 2 HR, 3 RBI, 2 R",
+		"Top 1st • NYY 1, SEA 0 • Aaron Judge homers.",
+		"Bot 4th • NYY 1, SEA 4 • Julio Rodriguez homers.",
+		"Time 2:47",
+		"Att 45,123",
+	} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("expected %q in recap, got:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "strikes out") {
+		t.Fatalf("expected only scoring plays in the recap")
+	}
+
+	gm, _ = gm.Update(keyPress("p"))
+	if gm.screen != screenPlays || !gm.InSubScreen() {
+		t.Fatalf("expected p to open the play-by-play")
+	}
+	gm, _ = gm.Update(keyPress("p"))
+	if gm.screen != screenLive {
+		t.Fatalf("expected p to return to the recap")
+	}
+}
+
+func TestTopPerformersRanking(t *testing.T) {
+	feed := recapFeed()
+	box, teams := feed.LiveData.Boxscore, feed.GameData.Teams
+
+	hitters := topHitters(box, teams, 3)
+	if len(hitters) != 2 || hitters[0].name != "J. Rodriguez" || hitters[1].name != "A. Judge" {
+		t.Fatalf("unexpected hitters %+v", hitters)
+	}
+	pitchers := topPitchers(box, teams, 2)
+	if len(pitchers) != 2 || pitchers[0].name != "G. Kirby" || pitchers[1].name != "A. Munoz" {
+		t.Fatalf("unexpected pitchers %+v", pitchers)
+	}
+}
+
+func TestInningsToOuts(t *testing.T) {
+	tests := map[string]int{"6.2": 20, "0.1": 1, "9.0": 27, "": 0}
+	for innings, want := range tests {
+		if got := inningsToOuts(innings); got != want {
+			t.Fatalf("inningsToOuts(%q) = %d, want %d", innings, got, want)
+		}
+	}
+}
+
+func TestRenderScoringPlaysKeepsLatest(t *testing.T) {
+	var plays []mlb.Play
+	for inning := 1; inning <= 9; inning++ {
+		plays = append(plays, mlb.Play{About: mlb.PlayAbout{Inning: inning, IsScoringPlay: true}, Result: mlb.PlayResult{Description: "Run scores."}})
+	}
+	out := renderScoringPlays(plays, mlb.GameTeams{}, 0, 6)
+	if !strings.Contains(out, "6 earlier scoring plays") || !strings.Contains(out, "Bot 9th") || strings.Contains(out, "Bot 6th") {
+		t.Fatalf("expected only the latest plays to be kept, got:\n%s", out)
+	}
+}
@@ -23,11 +23,17 @@ func (g *GameModel) renderPreview() string {
 
 	awayLines := previewTeamLines(teams.Away, probables.Away, box.Teams.Away.Players)
 	homeLines := previewTeamLines(teams.Home, probables.Home, box.Teams.Home.Players)
+	if probables.Away != nil {
+		awayLines = append(awayLines, recentStartsLines(g.gameLogs[probables.Away.ID], recentStartsCount)...)
+	}
+	if probables.Home != nil {
+		homeLines = append(homeLines, recentStartsLines(g.gameLogs[probables.Home.ID], recentStartsCount)...)
+	}
 
 	startTime := "Start time TBD"
 	if !g.feed.GameData.Status.StartTimeTBD {
 		if t, err := time.Parse(time.RFC3339, g.feed.GameData.Datetime.DateTime); err == nil {
-			startTime = t.Local().Format("Monday, January 2, 2006 3:04 PM MST")
+			startTime = g.clock.In(t).Format("Monday, January 2, 2006 3:04 PM MST")
 		}
 	}
 
@@ -37,12 +43,101 @@ func (g *GameModel) renderPreview() string {
 		venue.Location.City,
 		venue.Location.StateAbbrev,
 	)
+	if info := gameInfoSummary(g.feed, g.clock.Now()); info != "" {
+		middle += "\n\n" + info
+	}
+	if tv := formatBroadcasts(g.broadcasts, true); tv != "" {
+		middle += "\n\nTV: " + tv
+	}
+	if radio := formatBroadcasts(g.broadcasts, false); radio != "" {
+		middle += "\nRadio: " + radio
+	}
 
-	return lipgloss.JoinHorizontal(lipgloss.Top,
+	summary := lipgloss.JoinHorizontal(lipgloss.Top,
 		columnStyle.Render(strings.Join(awayLines, "\n")),
 		columnStyle.Render(middle),
 		columnStyle.Render(strings.Join(homeLines, "\n")),
 	)
+
+	lineups := lipgloss.JoinHorizontal(lipgloss.Top,
+		lineupStyle.Render(renderLineup(teams.Away.Abbreviation, box.Teams.Away, g.feed.GameData.Players)),
+		lineupStyle.Render(renderLineup(teams.Home.Abbreviation, box.Teams.Home, g.feed.GameData.Players)),
+	)
+
+	return lipgloss.JoinVertical(lipgloss.Center, summary, lineups)
+}
+
+// recentStartsLines summarises a pitcher's most recent starts, newest first.
+func recentStartsLines(splits []mlb.GameLogSplit, limit int) []string {
+	var starts []mlb.GameLogSplit
+	for i := len(splits) - 1; i >= 0 && len(starts) < limit; i-- {
+		if splits[i].Stat.GamesStarted > 0 {
+			starts = append(starts, splits[i])
+		}
+	}
+	if len(starts) == 0 {
+		return nil
+	}
+
+	lines := []string{"", fmt.Sprintf("Last %d starts", len(starts))}
+	for _, start := range starts {
+		date := start.Date
+		if t, err := time.Parse("2006-01-02", start.Date); err == nil {
+			date = t.Format("1/2")
+		}
+		vs := "@"
+		if start.IsHome {
+			vs = "vs"
+		}
+		lines = append(lines,
+			fmt.Sprintf("%s %s %s", date, vs, truncateText(start.Opponent.Name, 14)),
+			fmt.Sprintf("%s IP %d ER %d K", start.Stat.InningsPitched, start.Stat.EarnedRuns, start.Stat.StrikeOuts),
+		)
+	}
+	return lines
+}
+
+// renderLineup lists a club's starting lineup once the batting order is posted.
+func renderLineup(abbrev string, team mlb.BoxscoreTeam, players map[string]mlb.GamePlayer) string {
+	title := bullpenTitleStyle.Render(safeTeam(abbrev) + " Lineup")
+	if len(team.BattingOrder) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, title, pitchMixEmptyStyle.Render("Lineup not yet posted"))
+	}
+
+	tbl := table.New().
+		Border(lipgloss.RoundedBorder()).
+		Headers("#", "Pos", "Player", "B", "AVG/OBP/SLG")
+	for idx, id := range team.BattingOrder {
+		key := fmt.Sprintf("ID%d", id)
+		player := team.Players[key]
+		bio := players[key]
+
+		name := player.Person.FullName
+		if name == "" {
+			name = bio.FullName
+		}
+		position := player.Position.Abbreviation
+		if position == "" {
+			position = bio.PrimaryPosition.Abbreviation
+		}
+		batting := player.SeasonStats.Batting
+
+		tbl = tbl.Row(
+			fmt.Sprintf("%d", idx+1),
+			position,
+			shortName(name),
+			bio.BatSide.Code,
+			fmt.Sprintf("%s/%s/%s", statOrDash(batting.AVG), statOrDash(batting.OBP), statOrDash(batting.SLG)),
+		)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, title, tbl.String())
+}
+
+func statOrDash(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "-"
+	}
+	return value
 }
 
 func previewTeamLines(team mlb.GameTeam, probable *mlb.PersonRef, roster map[string]mlb.BoxscorePlayer) []string {
@@ -52,7 +147,7 @@ func previewTeamLines(team mlb.GameTeam, probable *mlb.PersonRef, roster map[str
 		if player, ok := roster[key]; ok {
 			lines = append(lines, fmt.Sprintf("\n(#%s) %s", player.JerseyNumber, player.Person.FullName))
 			lines = append(lines, fmt.Sprintf("%d-%d", player.SeasonStats.Pitching.Wins, player.SeasonStats.Pitching.Losses))
-			lines = append(lines, fmt.Sprintf("%s ERA %d K", player.SeasonStats.Pitching.ERA, player.SeasonStats.Pitching.StrikeOuts))
+			lines = append(lines, fmt.Sprintf("%s ERA %d K", statOrDash(player.SeasonStats.Pitching.ERA), player.SeasonStats.Pitching.StrikeOuts))
 		}
 	} else {
 		lines = append(lines, "", "Probable: TBD")
@@ -79,12 +174,17 @@ func (g *GameModel) renderLive() string {
 		playAvailable = true
 	}
 
+	if !g.baseAnim.done() && g.selectedPlay == len(g.playViews)-1 {
+		linescore.Offense = g.baseAnim.offense(linescore.Offense)
+	}
+
 	lineScoreTable := lipgloss.NewStyle().PaddingRight(1).Render(renderLineScoreTable(linescore, teams))
 
 	header := lipgloss.JoinHorizontal(lipgloss.Center,
 		renderInning(linescore),
 		countStyle.Render(renderCount(linescore)),
-		basesStyle.Render(renderBases(linescore)),
+		basesStyle.Render(renderBases(linescore.Offense)),
+		runnersStyle.Render(renderBaseRunners(linescore, g.feed.LiveData.Boxscore, teams)),
 	)
 
 	if g.width/2 < (lipgloss.Width(header) + lipgloss.Width(lineScoreTable)) {
@@ -102,14 +202,23 @@ func (g *GameModel) renderLive() string {
 	header = styles.LiveGameSectionWrapper.Render(header)
 
 	matchup := ""
+	pitchMix := ""
 	atBat := ""
 	if playAvailable {
 		matchup = renderMatchup(play, g.feed.LiveData.Boxscore, teams)
+		isLatest := g.selectedPlay >= len(g.playViews)-1
+		if _, offense, ok := alignmentForPlay(play, g.feed.LiveData.Linescore, isLatest); ok {
+			if dueUp := renderDueUp(offense, g.feed.LiveData.Boxscore, teams); dueUp != "" {
+				matchup = lipgloss.JoinVertical(lipgloss.Left, matchup, dueUpStyle.Render(dueUp))
+			}
+		}
+		pitchMix = renderPitchMix(findPitcherUsage(buildPitcherUsage(g.playsThroughSelected()), play.Matchup.Pitcher.ID))
 		atBat = renderAtBat(play)
 	}
 
 	atBat = lipgloss.JoinVertical(lipgloss.Left,
 		matchup,
+		pitchMix,
 		atBat,
 	)
 
@@ -185,13 +294,23 @@ func renderMatchup(play mlb.Play, box mlb.Boxscore, teams mlb.GameTeams) string
 	pitchTeam = safeTeam(pitchTeam)
 	batTeam = safeTeam(batTeam)
 
+	// Minor league feeds often leave players out of the boxscore or omit season stats.
+	pitcherName := pitcher.Person.FullName
+	if pitcherName == "" {
+		pitcherName = play.Matchup.Pitcher.FullName
+	}
+	batterName := batter.Person.FullName
+	if batterName == "" {
+		batterName = play.Matchup.Batter.FullName
+	}
+
 	pitchLine := fmt.Sprintf("%s Pitching\n -  %s %s IP, %d P, %s ERA",
-		pitchTeam, safeName(pitcher.Person.FullName), pitcher.Stats.Pitching.InningsPitched,
-		pitcher.Stats.Pitching.PitchesThrown, pitcher.SeasonStats.Pitching.ERA)
+		pitchTeam, safeName(pitcherName), statOrDash(pitcher.Stats.Pitching.InningsPitched),
+		pitcher.Stats.Pitching.PitchesThrown, statOrDash(pitcher.SeasonStats.Pitching.ERA))
 
 	batLine := fmt.Sprintf("%s At Bat\n -  %s %d-%d, %s AVG, %d HR",
-		batTeam, safeName(batter.Person.FullName), batter.Stats.Batting.Hits, batter.Stats.Batting.AtBats,
-		batter.SeasonStats.Batting.AVG, batter.SeasonStats.Batting.HomeRuns)
+		batTeam, safeName(batterName), batter.Stats.Batting.Hits, batter.Stats.Batting.AtBats,
+		statOrDash(batter.SeasonStats.Batting.AVG), batter.SeasonStats.Batting.HomeRuns)
 
 	return lipgloss.JoinVertical(lipgloss.Left, pitchLine, batLine)
 }
@@ -256,7 +375,7 @@ func renderCount(linescore mlb.LiveLineScore) string {
 	}, "\n")
 }
 
-func renderBases(linescore mlb.LiveLineScore) string {
+func renderBases(offense mlb.OffensiveState) string {
 	on := styles.OnBaseColor
 	diamond := func(active bool) string {
 		if active {
@@ -265,11 +384,58 @@ func renderBases(linescore mlb.LiveLineScore) string {
 		return "◇"
 	}
 	return strings.Join([]string{
-		"  " + diamond(linescore.Offense.Second != nil),
-		fmt.Sprintf("%s   %s", diamond(linescore.Offense.Third != nil), diamond(linescore.Offense.First != nil)),
+		"  " + diamond(offense.Second != nil),
+		fmt.Sprintf("%s   %s", diamond(offense.Third != nil), diamond(offense.First != nil)),
 	}, "\n")
 }
 
+// renderBaseRunners lists who is on each occupied base, flagging base stealing threats.
+func renderBaseRunners(linescore mlb.LiveLineScore, box mlb.Boxscore, teams mlb.GameTeams) string {
+	bases := []struct {
+		label  string
+		runner *mlb.BaseRunner
+	}{
+		{"3B", linescore.Offense.Third},
+		{"2B", linescore.Offense.Second},
+		{"1B", linescore.Offense.First},
+	}
+
+	var lines []string
+	for _, base := range bases {
+		if base.runner == nil {
+			continue
+		}
+		player, _ := lookupPlayer(base.runner.ID, box, teams)
+		line := fmt.Sprintf("%s %s", base.label, shortName(player.Person.FullName))
+		if player.JerseyNumber != "" {
+			line = fmt.Sprintf("%s #%s %s", base.label, player.JerseyNumber, shortName(player.Person.FullName))
+		}
+		if isStealThreat(player) {
+			line += " " + stealThreatStyle.Render(fmt.Sprintf("⚡%d SB", player.SeasonStats.Batting.StolenBases))
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return emptyBasesStyle.Render("Bases empty")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isStealThreat reports whether a runner has stolen enough bases this season to watch.
+func isStealThreat(player mlb.BoxscorePlayer) bool {
+	return player.SeasonStats.Batting.StolenBases >= stealThreatMinimum
+}
+
+// shortName abbreviates a full name to an initial and surname, e.g. "J. Smith".
+func shortName(name string) string {
+	parts := strings.Fields(name)
+	if len(parts) < 2 {
+		return safeName(name)
+	}
+	first := []rune(parts[0])
+	return fmt.Sprintf("%c. %s", first[0], strings.Join(parts[1:], " "))
+}
+
 func bullet(count, total int, clr color.Color) string {
 	active := lipgloss.NewStyle().Foreground(clr).Render("●")
 	inactive := "○"
@@ -339,38 +505,39 @@ func buildPlayViews(plays []mlb.Play, snapshots []playSnapshot) []playView {
 		return nil
 	}
 	views := make([]playView, 0, len(plays))
-	var (
-		prevInning int
-		prevIsTop  bool
-		havePrev   bool
-	)
 	for i := range len(plays) {
-		play := plays[i]
-		lines := renderPlayLines(play)
-		if len(lines) == 0 {
-			lines = []string{""}
-		}
-		headerIndex := 0
-		isNewHalf := !havePrev || play.About.Inning != prevInning || play.About.IsTopInning != prevIsTop
-		if isNewHalf {
-			separator := renderHalfInningSeparator(play, havePrev)
-			lines = append([]string{separator}, lines...)
-			headerIndex = 1
-			prevInning = play.About.Inning
-			prevIsTop = play.About.IsTopInning
-			havePrev = true
+		var prev *mlb.Play
+		if i > 0 {
+			prev = &plays[i-1]
 		}
-		views = append(views, playView{
-			play:        play,
-			snapshot:    snapshots[i],
-			lines:       lines,
-			headerIndex: headerIndex,
-			lineCount:   len(lines),
-		})
+		views = append(views, newPlayView(plays[i], snapshots[i], prev))
 	}
 	return views
 }
 
+// newPlayView renders one play, opening with a half-inning separator when it
+// starts a new half from prev. A nil prev means the play is the game's first.
+func newPlayView(play mlb.Play, snapshot playSnapshot, prev *mlb.Play) playView {
+	lines := renderPlayLines(play)
+	if len(lines) == 0 {
+		lines = []string{""}
+	}
+	headerIndex := 0
+	if prev == nil || play.About.Inning != prev.About.Inning || play.About.IsTopInning != prev.About.IsTopInning {
+		separator := renderHalfInningSeparator(play, prev != nil)
+		lines = append([]string{separator}, lines...)
+		headerIndex = 1
+	}
+	return playView{
+		play:        play,
+		key:         keyForPlay(play),
+		snapshot:    snapshot,
+		lines:       lines,
+		headerIndex: headerIndex,
+		lineCount:   len(lines),
+	}
+}
+
 func renderHalfInningSeparator(play mlb.Play, spaced bool) string {
 	half := "Bottom"
 	if play.About.IsTopInning {
@@ -437,12 +604,23 @@ func renderInning(linescore mlb.LiveLineScore) string {
 	return inningStyle.Render(fmt.Sprintf("\n%d\n▼", linescore.CurrentInning))
 }
 
+const (
+	// stealThreatMinimum is the season stolen base total that marks a runner as a threat to run.
+	stealThreatMinimum = 15
+	// recentStartsCount is how many prior starts are shown for each probable pitcher.
+	recentStartsCount = 3
+)
+
 var (
 	columnStyle              = lipgloss.NewStyle().Width(30).Align(lipgloss.Center).Padding(0, 2)
+	lineupStyle              = lipgloss.NewStyle().Padding(0, 2)
 	tableStyle               = lipgloss.NewStyle().Padding(0, 1)
 	inningStyle              = lipgloss.NewStyle().Align(lipgloss.Right).PaddingLeft(1)
 	countStyle               = lipgloss.NewStyle().MarginLeft(2)
 	basesStyle               = lipgloss.NewStyle().MarginLeft(2)
+	runnersStyle             = lipgloss.NewStyle().MarginLeft(2)
+	emptyBasesStyle          = lipgloss.NewStyle().Faint(true).Italic(true)
+	stealThreatStyle         = lipgloss.NewStyle().Foreground(styles.OnBaseColor).Bold(true)
 	selectedPlayStyle        = lipgloss.NewStyle().Bold(true)
 	selectedPlayDetailStyle  = lipgloss.NewStyle().Foreground(lipgloss.Yellow).Italic(true)
 	atBatNumberStyle         = lipgloss.NewStyle().Foreground(lipgloss.Cyan).Bold(true)
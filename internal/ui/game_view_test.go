@@ -75,12 +75,62 @@ func TestRenderBasesHighlightsOccupied(t *testing.T) {
 			Third:  &mlb.BaseRunner{ID: 2},
 		},
 	}
-	out := renderBases(ls)
+	out := renderBases(ls.Offense)
 	if strings.Count(out, "◆") != 2 {
 		t.Fatalf("expected two occupied bases, got: %q", out)
 	}
 }
 
+func TestRenderBaseRunnersShowsNamesAndThreats(t *testing.T) {
+	ls := mlb.LiveLineScore{
+		Offense: mlb.OffensiveState{
+			First: &mlb.BaseRunner{ID: 1},
+			Third: &mlb.BaseRunner{ID: 2},
+		},
+	}
+	box := mlb.Boxscore{}
+	box.Teams.Away.Players = map[string]mlb.BoxscorePlayer{
+		"ID1": {
+			Person:       mlb.PersonInfo{FullName: "Speedy Runner"},
+			JerseyNumber: "7",
+			SeasonStats:  mlb.BoxscorePlayerSeason{Batting: mlb.SeasonBatting{StolenBases: 40}},
+		},
+		"ID2": {
+			Person:       mlb.PersonInfo{FullName: "Slow Catcher"},
+			JerseyNumber: "12",
+			SeasonStats:  mlb.BoxscorePlayerSeason{Batting: mlb.SeasonBatting{StolenBases: 1}},
+		},
+	}
+	out := renderBaseRunners(ls, box, mlb.GameTeams{})
+	lines := strings.Split(out, "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected one line per runner, got %q", out)
+	}
+	if !strings.Contains(lines[0], "3B #12 S. Catcher") || strings.Contains(lines[0], "SB") {
+		t.Fatalf("expected runner on third without threat marker, got %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "1B #7 S. Runner") || !strings.Contains(lines[1], "40 SB") {
+		t.Fatalf("expected runner on first flagged as threat, got %q", lines[1])
+	}
+
+	if got := renderBaseRunners(mlb.LiveLineScore{}, box, mlb.GameTeams{}); !strings.Contains(got, "Bases empty") {
+		t.Fatalf("expected empty bases label, got %q", got)
+	}
+}
+
+func TestShortName(t *testing.T) {
+	cases := map[string]string{
+		"Ronald Acuna Jr.": "R. Acuna Jr.",
+		"Ichiro":           "Ichiro",
+		"":                 "Unknown",
+	}
+	for input, want := range cases {
+		if got := shortName(input); got != want {
+			t.Fatalf("shortName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
 func TestRenderPlayLinesIncludesEventAndDetails(t *testing.T) {
 	play := mlb.Play{
 		AtBatIndex: 12,
@@ -187,6 +237,19 @@ func TestRenderMatchupFallbacks(t *testing.T) {
 	}
 }
 
+func TestRenderMatchupSparseMinorLeagueFeed(t *testing.T) {
+	play := mlb.Play{Matchup: mlb.PlayMatchup{
+		Pitcher: mlb.PersonRef{ID: 1, FullName: "Prospect Arm"},
+		Batter:  mlb.PersonRef{ID: 2, FullName: "Prospect Bat"},
+	}}
+	out := renderMatchup(play, mlb.Boxscore{}, mlb.GameTeams{})
+	for _, want := range []string{"Prospect Arm", "Prospect Bat", "- ERA", "- AVG"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in matchup without boxscore data, got %q", want, out)
+		}
+	}
+}
+
 func TestRenderLineScoreTableIncludesTotals(t *testing.T) {
 	two := 2
 	ls := mlb.LiveLineScore{
@@ -233,3 +296,46 @@ func TestSafeHelpers(t *testing.T) {
 		t.Fatalf("expected UNK fallback, got %q", got)
 	}
 }
+
+func TestRecentStartsLinesNewestFirst(t *testing.T) {
+	splits := []mlb.GameLogSplit{
+		{Date: "2024-04-01", Opponent: mlb.TeamRef{Name: "Oldest"}, Stat: mlb.GameLogStat{GamesStarted: 1}},
+		{Date: "2024-04-05", Opponent: mlb.TeamRef{Name: "Relief"}, Stat: mlb.GameLogStat{}},
+		{Date: "2024-04-07", Opponent: mlb.TeamRef{Name: "Middle"}, Stat: mlb.GameLogStat{GamesStarted: 1}},
+		{Date: "2024-04-12", IsHome: true, Opponent: mlb.TeamRef{Name: "Newest"}, Stat: mlb.GameLogStat{GamesStarted: 1, InningsPitched: "6.0", EarnedRuns: 1, StrikeOuts: 8}},
+	}
+	lines := recentStartsLines(splits, 2)
+	joined := strings.Join(lines, "\n")
+	if strings.Contains(joined, "Relief") || strings.Contains(joined, "Oldest") {
+		t.Fatalf("expected only the two most recent starts, got %q", joined)
+	}
+	if !strings.Contains(lines[2], "4/12 vs Newest") || !strings.Contains(lines[3], "6.0 IP 1 ER 8 K") {
+		t.Fatalf("expected newest start first, got %q", joined)
+	}
+	if got := recentStartsLines(nil, 3); got != nil {
+		t.Fatalf("expected no lines without starts, got %v", got)
+	}
+}
+
+func TestRenderLineupShowsBattingOrder(t *testing.T) {
+	team := mlb.BoxscoreTeam{
+		BattingOrder: []int{10},
+		Players: map[string]mlb.BoxscorePlayer{
+			"ID10": {
+				Person:      mlb.PersonInfo{FullName: "Lead Off"},
+				Position:    mlb.Position{Abbreviation: "CF"},
+				SeasonStats: mlb.BoxscorePlayerSeason{Batting: mlb.SeasonBatting{AVG: ".300", OBP: ".380", SLG: ".450"}},
+			},
+		},
+	}
+	bios := map[string]mlb.GamePlayer{"ID10": {BatSide: mlb.Handedness{Code: "L"}}}
+	out := renderLineup("AWY", team, bios)
+	for _, want := range []string{"AWY Lineup", "CF", "L. Off", ".300/.380/.450"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in lineup, got %q", want, out)
+		}
+	}
+	if got := renderLineup("AWY", mlb.BoxscoreTeam{}, nil); !strings.Contains(got, "not yet posted") {
+		t.Fatalf("expected placeholder before lineups post, got %q", got)
+	}
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea/v2"
@@ -10,10 +11,19 @@ import (
 
 type GridItem string
 
+// gridHelpHeight is the space taken by the padded help line under the tiles.
+const gridHelpHeight = 3
+
 // GridModel renders schedule items in a grid instead of a single column.
+// Items sharing a group are kept on the same row and drawn as a set.
 type GridModel struct {
-	items  []GridItem
-	cursor int
+	items    []GridItem
+	groups   []int
+	flashing map[int]bool
+	rows     [][]int
+	cursor   int
+	// offset is the first row drawn; rows scroll so the cursor stays visible.
+	offset int
 
 	width  int
 	height int
@@ -39,6 +49,8 @@ func (m *GridModel) SetSize(width, height int) {
 
 func (m *GridModel) SetItems(items []GridItem) {
 	m.items = items
+	m.groups = nil
+	m.flashing = nil
 
 	m.itemWidth = 0
 	m.itemHeight = 0
@@ -60,6 +72,25 @@ func (m *GridModel) SetItems(items []GridItem) {
 	m.calculateLayout()
 }
 
+// SetGroups assigns each item a group ID, where zero means ungrouped.
+// Grouped items must already be adjacent in the item list.
+func (m *GridModel) SetGroups(groups []int) {
+	m.groups = groups
+	m.calculateLayout()
+}
+
+// SetFlashing marks items, by index, to be drawn with an attention-grabbing border.
+func (m *GridModel) SetFlashing(flashing map[int]bool) {
+	m.flashing = flashing
+}
+
+func (m GridModel) groupOf(idx int) int {
+	if idx < 0 || idx >= len(m.groups) {
+		return 0
+	}
+	return m.groups[idx]
+}
+
 func (m *GridModel) SetCursor(pos int) {
 	if len(m.items) == 0 {
 		m.cursor = 0
@@ -74,6 +105,7 @@ func (m *GridModel) SetCursor(pos int) {
 	}
 
 	m.cursor = pos
+	m.ensureVisible()
 }
 
 func (m GridModel) Init() tea.Cmd {
@@ -94,14 +126,22 @@ func (m GridModel) Update(msg tea.Msg) (GridModel, tea.Cmd) {
 			return m, tea.Quit
 
 		case "k", "up":
-			if m.cursor >= m.itemsPerRow {
-				m.cursor -= m.itemsPerRow
-			}
+			m.moveRow(-1)
 
 		case "j", "down":
-			if m.cursor+m.itemsPerRow < len(m.items) {
-				m.cursor += m.itemsPerRow
-			}
+			m.moveRow(1)
+
+		case "pgup":
+			m.moveRow(-m.visibleRows())
+
+		case "pgdown":
+			m.moveRow(m.visibleRows())
+
+		case "home", "g":
+			m.cursor = 0
+
+		case "end", "G":
+			m.cursor = max(len(m.items)-1, 0)
 
 		case "h", "left":
 			if m.cursor > 0 {
@@ -113,11 +153,64 @@ func (m GridModel) Update(msg tea.Msg) (GridModel, tea.Cmd) {
 				m.cursor++
 			}
 		}
+		m.ensureVisible()
 	}
 
 	return m, nil
 }
 
+// moveRow moves the cursor to the same column of a row delta rows away,
+// stopping at the first or last row and clamping to the end of shorter rows.
+func (m *GridModel) moveRow(delta int) {
+	row, col := m.position(m.cursor)
+	if row < 0 {
+		return
+	}
+	target := min(max(row+delta, 0), len(m.rows)-1)
+	m.cursor = m.rows[target][min(col, len(m.rows[target])-1)]
+}
+
+// rowHeight is the tallest a row of tiles renders: the tile, its border and margin.
+func (m GridModel) rowHeight() int {
+	return m.itemHeight + 3
+}
+
+// visibleRows is how many rows fit above the help line. Without a height
+// every row is shown.
+func (m GridModel) visibleRows() int {
+	if m.height <= 0 {
+		return max(len(m.rows), 1)
+	}
+	return max((m.height-gridHelpHeight)/m.rowHeight(), 1)
+}
+
+// ensureVisible scrolls the minimum amount needed to show the cursor's row.
+func (m *GridModel) ensureVisible() {
+	visible := m.visibleRows()
+	if row, _ := m.position(m.cursor); row >= 0 {
+		if row < m.offset {
+			m.offset = row
+		}
+		if row >= m.offset+visible {
+			m.offset = row - visible + 1
+		}
+	}
+	m.offset = min(m.offset, max(len(m.rows)-visible, 0))
+	m.offset = max(m.offset, 0)
+}
+
+// position returns the row and column holding an item.
+func (m GridModel) position(idx int) (int, int) {
+	for row, items := range m.rows {
+		for col, item := range items {
+			if item == idx {
+				return row, col
+			}
+		}
+	}
+	return -1, -1
+}
+
 func (m GridModel) View() string {
 	var b strings.Builder
 
@@ -129,37 +222,46 @@ func (m GridModel) View() string {
 		Width(m.itemWidth).
 		Height(m.itemHeight).Margin(0, 1, 1)
 
-	rows := (len(m.items) + m.itemsPerRow - 1) / m.itemsPerRow
+	groupedStyle := normalStyle.Border(lipgloss.NormalBorder()).BorderForeground(lipgloss.BrightBlack)
+	flashStyle := normalStyle.Border(lipgloss.ThickBorder()).BorderForeground(lipgloss.Yellow)
 
-	for row := range rows {
+	visible := m.visibleRows()
+	end := min(m.offset+visible, len(m.rows))
+	for _, row := range m.rows[m.offset:end] {
 		var rowItems []string
 
-		for col := 0; col < m.itemsPerRow; col++ {
-			idx := row*m.itemsPerRow + col
-
-			if idx >= len(m.items) {
-				// Empty placeholder
-				emptyStyle := lipgloss.NewStyle().
-					Width(m.itemWidth + 2).
-					Height(m.itemHeight + 2)
-				rowItems = append(rowItems, emptyStyle.Render(""))
-				continue
-			}
-
+		for _, idx := range row {
 			var rendered string
-			if idx == m.cursor {
+			switch {
+			case idx == m.cursor:
 				rendered = selectedStyle.Render(string(m.items[idx]))
-			} else {
+			case m.flashing[idx]:
+				rendered = flashStyle.Render(string(m.items[idx]))
+			case m.groupOf(idx) != 0:
+				rendered = groupedStyle.Render(string(m.items[idx]))
+			default:
 				rendered = normalStyle.Render(string(m.items[idx]))
 			}
 
 			rowItems = append(rowItems, rendered)
 		}
 
+		for len(rowItems) < m.itemsPerRow {
+			// Empty placeholder
+			emptyStyle := lipgloss.NewStyle().
+				Width(m.itemWidth + 2).
+				Height(m.itemHeight + 2)
+			rowItems = append(rowItems, emptyStyle.Render(""))
+		}
+
 		b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, rowItems...) + "\n")
 	}
 
-	b.WriteString(styles.HelpTextStyle.Render("hjkl / ←↓↑→ to navigate • q to quit"))
+	help := "hjkl / ←↓↑→ to navigate • q to quit"
+	if len(m.rows) > visible {
+		help = fmt.Sprintf("rows %d–%d of %d • pgup / pgdown to page • %s", m.offset+1, end, len(m.rows), help)
+	}
+	b.WriteString(styles.HelpTextStyle.Render(help))
 
 	return b.String()
 }
@@ -169,4 +271,29 @@ func (m *GridModel) calculateLayout() {
 	// Each item takes itemWidth + 2 spaces (1 on each side)
 	totalItemWidth := m.itemWidth + 2
 	m.itemsPerRow = max(1, m.width/totalItemWidth)
+
+	m.rows = nil
+	var row []int
+	for idx := 0; idx < len(m.items); idx++ {
+		// Start a group on a fresh row when it would otherwise be split across two.
+		if group := m.groupOf(idx); group != 0 && group != m.groupOf(idx-1) && len(row) > 0 {
+			size := 1
+			for m.groupOf(idx+size) == group {
+				size++
+			}
+			if len(row)+size > m.itemsPerRow && size <= m.itemsPerRow {
+				m.rows = append(m.rows, row)
+				row = nil
+			}
+		}
+		row = append(row, idx)
+		if len(row) == m.itemsPerRow {
+			m.rows = append(m.rows, row)
+			row = nil
+		}
+	}
+	if len(row) > 0 {
+		m.rows = append(m.rows, row)
+	}
+	m.ensureVisible()
 }
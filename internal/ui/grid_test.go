@@ -1,6 +1,11 @@
 package ui
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+)
 
 func TestGridSetItemsCalculatesDimensions(t *testing.T) {
 	m := NewGridModel()
@@ -34,4 +39,92 @@ func TestGridSetCursorClamps(t *testing.T) {
 }
 
 // Navigation is handled by bubbletea key messages; direct cursor mutation is covered
-// via SetCursor and SetItems tests above.
+// via SetCursor and SetItems tests above, and row movement via moveRow below.
+
+func TestGridKeepsGroupsOnOneRow(t *testing.T) {
+	m := NewGridModel()
+	m.SetItems([]GridItem{"a", "b", "c", "d", "e"})
+	m.SetSize((m.itemWidth+2)*3, 20)
+	m.SetGroups([]int{0, 0, 1, 1, 0})
+
+	want := [][]int{{0, 1}, {2, 3, 4}}
+	if len(m.rows) != len(want) {
+		t.Fatalf("expected rows %v, got %v", want, m.rows)
+	}
+	for row := range want {
+		for col := range want[row] {
+			if m.rows[row][col] != want[row][col] {
+				t.Fatalf("expected rows %v, got %v", want, m.rows)
+			}
+		}
+	}
+
+	m.SetCursor(1)
+	m.moveRow(1)
+	if m.cursor != 3 {
+		t.Fatalf("expected moving down from column 1 to land on item 3, got %d", m.cursor)
+	}
+	m.SetCursor(4)
+	m.moveRow(-1)
+	if m.cursor != 1 {
+		t.Fatalf("expected moving up from column 2 to clamp to item 1, got %d", m.cursor)
+	}
+}
+
+func TestGridScrollsToFollowCursor(t *testing.T) {
+	m := NewGridModel()
+	m.SetItems([]GridItem{"a", "b", "c", "d", "e", "f"})
+	m.SetSize(m.itemWidth+2, gridHelpHeight+2*m.rowHeight())
+
+	if got := m.visibleRows(); got != 2 {
+		t.Fatalf("expected 2 visible rows, got %d", got)
+	}
+
+	m, _ = m.Update(keyPress("j"))
+	m, _ = m.Update(keyPress("j"))
+	if m.cursor != 2 || m.offset != 1 {
+		t.Fatalf("expected cursor 2 at offset 1, got cursor %d offset %d", m.cursor, m.offset)
+	}
+	if view := m.View(); !strings.Contains(view, "rows 2–3 of 6") {
+		t.Fatalf("expected scroll indicator in view, got %q", view)
+	}
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyPgDown})
+	if m.cursor != 4 || m.offset != 3 {
+		t.Fatalf("expected page down to reach cursor 4 at offset 3, got cursor %d offset %d", m.cursor, m.offset)
+	}
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyPgDown})
+	if m.cursor != 5 {
+		t.Fatalf("expected page down to stop on the last row, got cursor %d", m.cursor)
+	}
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyHome})
+	if m.cursor != 0 || m.offset != 0 {
+		t.Fatalf("expected home to return to the top, got cursor %d offset %d", m.cursor, m.offset)
+	}
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnd})
+	if m.cursor != 5 || m.offset != 4 {
+		t.Fatalf("expected end to show the last rows, got cursor %d offset %d", m.cursor, m.offset)
+	}
+}
+
+func TestGridShrinkingItemsClampsScroll(t *testing.T) {
+	m := NewGridModel()
+	m.SetItems([]GridItem{"a", "b", "c", "d", "e", "f"})
+	m.SetSize(m.itemWidth+2, gridHelpHeight+2*m.rowHeight())
+	m.SetCursor(5)
+
+	m.SetItems([]GridItem{"a", "b"})
+	if m.cursor != 1 || m.offset != 0 {
+		t.Fatalf("expected cursor 1 at offset 0 after shrinking, got cursor %d offset %d", m.cursor, m.offset)
+	}
+	if view := m.View(); strings.Contains(view, "rows ") {
+		t.Fatalf("expected no scroll indicator when everything fits, got %q", view)
+	}
+
+	m.SetItems(nil)
+	if m.cursor != 0 || m.offset != 0 {
+		t.Fatalf("expected empty grid to reset, got cursor %d offset %d", m.cursor, m.offset)
+	}
+	_ = m.View()
+}
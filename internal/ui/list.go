@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/lipgloss/v2/table"
+	"go.dalton.dog/batterup/internal/styles"
+)
+
+// listChromeHeight is the space a ListModel spends on the table's borders,
+// header row and the padded help line.
+const listChromeHeight = 4 + 3
+
+// ListModel renders rows of cells as a table with one highlighted row. It is
+// the dense alternative to GridModel, scrolling so the cursor stays visible.
+type ListModel struct {
+	headers []string
+	rows    [][]string
+	cursor  int
+	// offset is the first row drawn.
+	offset int
+
+	width  int
+	height int
+}
+
+func NewListModel(headers ...string) ListModel {
+	return ListModel{headers: headers}
+}
+
+func (m ListModel) GetIndex() int {
+	return m.cursor
+}
+
+func (m *ListModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.ensureVisible()
+}
+
+func (m *ListModel) SetRows(rows [][]string) {
+	m.rows = rows
+	m.SetCursor(m.cursor)
+}
+
+func (m *ListModel) SetCursor(pos int) {
+	m.cursor = min(max(pos, 0), max(len(m.rows)-1, 0))
+	m.ensureVisible()
+}
+
+func (m ListModel) Update(msg tea.Msg) (ListModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "k", "up", "h", "left":
+			m.SetCursor(m.cursor - 1)
+		case "j", "down", "l", "right":
+			m.SetCursor(m.cursor + 1)
+		case "pgup":
+			m.SetCursor(m.cursor - m.visibleRows())
+		case "pgdown":
+			m.SetCursor(m.cursor + m.visibleRows())
+		case "home", "g":
+			m.SetCursor(0)
+		case "end", "G":
+			m.SetCursor(len(m.rows) - 1)
+		}
+	}
+	return m, nil
+}
+
+// visibleRows is how many rows fit between the table chrome. Without a height
+// every row is shown.
+func (m ListModel) visibleRows() int {
+	if m.height <= 0 {
+		return max(len(m.rows), 1)
+	}
+	return max(m.height-listChromeHeight, 1)
+}
+
+// ensureVisible scrolls the minimum amount needed to show the cursor's row.
+func (m *ListModel) ensureVisible() {
+	visible := m.visibleRows()
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+visible {
+		m.offset = m.cursor - visible + 1
+	}
+	m.offset = max(min(m.offset, len(m.rows)-visible), 0)
+}
+
+func (m ListModel) View() string {
+	visible := m.visibleRows()
+	end := min(m.offset+visible, len(m.rows))
+
+	// Cells may carry their own colors, so the cursor is a marker column
+	// rather than a row style that inner styles would reset.
+	rows := make([][]string, 0, end-m.offset)
+	for idx := m.offset; idx < end; idx++ {
+		marker := " "
+		if idx == m.cursor {
+			marker = selectedPlayIndicator
+		}
+		rows = append(rows, append([]string{marker}, m.rows[idx]...))
+	}
+
+	tbl := table.New().
+		Border(lipgloss.RoundedBorder()).
+		Headers(append([]string{""}, m.headers...)...).
+		Rows(rows...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			switch {
+			case row == table.HeaderRow:
+				return styles.ScheduleTableHeader.Padding(0, 1)
+			case row+m.offset == m.cursor:
+				return listCursorStyle
+			}
+			return listCellStyle
+		})
+
+	help := "j / k to move • q to quit"
+	if len(m.rows) > visible {
+		help = fmt.Sprintf("rows %d–%d of %d • pgup / pgdown to page • %s", m.offset+1, end, len(m.rows), help)
+	}
+
+	var b strings.Builder
+	b.WriteString(tbl.String() + "\n")
+	b.WriteString(styles.HelpTextStyle.Render(help))
+	return b.String()
+}
+
+var (
+	listCellStyle   = lipgloss.NewStyle().Padding(0, 1)
+	listCursorStyle = listCellStyle.Bold(true)
+)
@@ -0,0 +1,305 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/lipgloss/v2/table"
+
+	"go.dalton.dog/batterup/internal/mlb"
+	"go.dalton.dog/batterup/internal/styles"
+)
+
+// pitcherUsage aggregates everything a single pitcher has thrown in the game.
+type pitcherUsage struct {
+	id           int
+	isHome       bool
+	pitches      int
+	strikes      int
+	whiffs       int
+	battersFaced int
+	types        []*pitchTypeUsage
+}
+
+// pitchTypeUsage aggregates one pitch type for a pitcher.
+type pitchTypeUsage struct {
+	code        string
+	description string
+	count       int
+	strikes     int
+	whiffs      int
+	veloTotal   float64
+	veloCount   int
+	veloMax     float64
+}
+
+func (u *pitcherUsage) strikePct() int {
+	return percent(u.strikes, u.pitches)
+}
+
+func (t *pitchTypeUsage) avgVelo() float64 {
+	if t.veloCount == 0 {
+		return 0
+	}
+	return t.veloTotal / float64(t.veloCount)
+}
+
+// buildPitcherUsage walks the plays in order and aggregates every pitch thrown,
+// returning pitchers in the order they first appeared. A pitching change in the
+// middle of an at-bat splits its pitches between the two pitchers, and the
+// batter counts as faced by the one who finished the at-bat.
+func buildPitcherUsage(plays []mlb.Play) []*pitcherUsage {
+	var (
+		usages []*pitcherUsage
+		byID   = make(map[int]*pitcherUsage)
+		// pitching holds each club's pitcher as of the last at-bat, keyed by
+		// whether the club is home.
+		pitching = make(map[bool]int)
+	)
+	usageFor := func(id int, isHome bool) *pitcherUsage {
+		usage, ok := byID[id]
+		if !ok {
+			usage = &pitcherUsage{id: id, isHome: isHome}
+			byID[id] = usage
+			usages = append(usages, usage)
+		}
+		return usage
+	}
+	for _, play := range plays {
+		finisher := play.Matchup.Pitcher.ID
+		if finisher == 0 {
+			continue
+		}
+		// The home club is in the field during the top half.
+		isHome := play.About.IsTopInning
+		current, ok := pitching[isHome]
+		if !ok {
+			current = finisher
+		}
+		for _, event := range play.PlayEvents {
+			if isPitchingChange(event) {
+				current = event.Player.ID
+				continue
+			}
+			if !event.IsPitch {
+				continue
+			}
+			usageFor(current, isHome).addPitch(event)
+		}
+		usageFor(finisher, isHome).battersFaced++
+		pitching[isHome] = finisher
+	}
+	for _, usage := range usages {
+		sort.SliceStable(usage.types, func(i, j int) bool {
+			return usage.types[i].count > usage.types[j].count
+		})
+	}
+	return usages
+}
+
+// isPitchingChange reports whether an event brings in a new pitcher.
+func isPitchingChange(event mlb.PlayEvent) bool {
+	return event.Details.EventType == "pitching_substitution" && event.Player != nil && event.Player.ID != 0
+}
+
+// unknownPitchCode groups pitches the feed did not classify.
+const unknownPitchCode = "UN"
+
+func (u *pitcherUsage) addPitch(event mlb.PlayEvent) {
+	code := event.Details.Type.Code
+	desc := event.Details.Type.Description
+	if code == "" && desc == "" {
+		code = unknownPitchCode
+		desc = "Unknown"
+	}
+	var pitchType *pitchTypeUsage
+	for _, existing := range u.types {
+		if existing.code == code && existing.description == desc {
+			pitchType = existing
+			break
+		}
+	}
+	if pitchType == nil {
+		pitchType = &pitchTypeUsage{code: code, description: desc}
+		u.types = append(u.types, pitchType)
+	}
+
+	u.pitches++
+	pitchType.count++
+	if isStrikePitch(event) {
+		u.strikes++
+		pitchType.strikes++
+	}
+	if isWhiff(event) {
+		u.whiffs++
+		pitchType.whiffs++
+	}
+	if event.PitchData != nil && event.PitchData.StartSpeed > 0 {
+		speed := event.PitchData.StartSpeed
+		pitchType.veloTotal += speed
+		pitchType.veloCount++
+		if speed > pitchType.veloMax {
+			pitchType.veloMax = speed
+		}
+	}
+}
+
+// findPitcherUsage returns the usage entry for the given pitcher, if any.
+func findPitcherUsage(usages []*pitcherUsage, id int) *pitcherUsage {
+	for _, usage := range usages {
+		if usage.id == id {
+			return usage
+		}
+	}
+	return nil
+}
+
+// isStrikePitch counts balls put in play as strikes, matching official strike totals.
+func isStrikePitch(event mlb.PlayEvent) bool {
+	return event.Details.IsStrike || event.Details.IsInPlay
+}
+
+func isWhiff(event mlb.PlayEvent) bool {
+	switch event.Details.Code {
+	case "S", "W", "M", "Q":
+		return true
+	}
+	desc := strings.ToLower(event.Details.Description)
+	return strings.Contains(desc, "swinging strike") || strings.Contains(desc, "missed bunt")
+}
+
+func percent(part, whole int) int {
+	if whole == 0 {
+		return 0
+	}
+	return (part*100 + whole/2) / whole
+}
+
+func formatVelo(speed float64) string {
+	if speed <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%0.1f", speed)
+}
+
+// Region: Rendering
+
+// renderPitchMix renders the per-pitch-type breakdown for one pitcher.
+func renderPitchMix(usage *pitcherUsage) string {
+	if usage == nil || usage.pitches == 0 {
+		return pitchMixEmptyStyle.Render("No pitches tracked yet")
+	}
+
+	summary := fmt.Sprintf("%d P • %d%% Strikes • %d Whiffs • %d BF",
+		usage.pitches, usage.strikePct(), usage.whiffs, usage.battersFaced)
+
+	// Lower-level feeds without pitch tracking report no pitch types at all.
+	if len(usage.types) == 1 && usage.types[0].code == unknownPitchCode {
+		return lipgloss.JoinVertical(lipgloss.Left,
+			pitchMixSummaryStyle.Render(summary),
+			pitchMixEmptyStyle.Render("Pitch types not tracked for this game"),
+		)
+	}
+
+	tbl := table.New().
+		Border(lipgloss.RoundedBorder()).
+		Headers("Pitch", "#", "Mix", "Str%", "Avg", "Max", "Whf")
+	for _, pitchType := range usage.types {
+		tbl = tbl.Row(
+			pitchTypeLabel(pitchType),
+			fmt.Sprintf("%d", pitchType.count),
+			fmt.Sprintf("%d%%", percent(pitchType.count, usage.pitches)),
+			fmt.Sprintf("%d%%", percent(pitchType.strikes, pitchType.count)),
+			formatVelo(pitchType.avgVelo()),
+			formatVelo(pitchType.veloMax),
+			fmt.Sprintf("%d", pitchType.whiffs),
+		)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		pitchMixSummaryStyle.Render(summary),
+		tbl.String(),
+	)
+}
+
+func pitchTypeLabel(pitchType *pitchTypeUsage) string {
+	if pitchType.description != "" {
+		return pitchType.description
+	}
+	return pitchType.code
+}
+
+// renderBullpenUsage lists every pitcher used by both clubs, in order of appearance.
+func renderBullpenUsage(usages []*pitcherUsage, box mlb.Boxscore, teams mlb.GameTeams) string {
+	var away, home []*pitcherUsage
+	for _, usage := range usages {
+		if usage.isHome {
+			home = append(home, usage)
+		} else {
+			away = append(away, usage)
+		}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		renderTeamBullpen(safeTeam(teams.Away.Abbreviation), away, box.Teams.Away.Players),
+		renderTeamBullpen(safeTeam(teams.Home.Abbreviation), home, box.Teams.Home.Players),
+		styles.HelpTextStyle.Render("b / esc to return to the game"),
+	)
+}
+
+func renderTeamBullpen(abbrev string, usages []*pitcherUsage, roster map[string]mlb.BoxscorePlayer) string {
+	title := bullpenTitleStyle.Render(abbrev + " Pitching")
+	if len(usages) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, title, pitchMixEmptyStyle.Render("No pitchers used yet"))
+	}
+
+	tbl := table.New().
+		Border(lipgloss.RoundedBorder()).
+		Headers("Pitcher", "IP", "BF", "P", "Str%", "Whf", "Mix")
+	for _, usage := range usages {
+		player := roster[fmt.Sprintf("ID%d", usage.id)]
+		ip := player.Stats.Pitching.InningsPitched
+		if ip == "" {
+			ip = "-"
+		}
+		tbl = tbl.Row(
+			safeName(player.Person.FullName),
+			ip,
+			fmt.Sprintf("%d", usage.battersFaced),
+			fmt.Sprintf("%d", usage.pitches),
+			fmt.Sprintf("%d%%", usage.strikePct()),
+			fmt.Sprintf("%d", usage.whiffs),
+			pitchMixSummary(usage, 3),
+		)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, title, tbl.String())
+}
+
+// pitchMixSummary condenses a pitcher's top pitch types, e.g. "FF 52% SL 30%".
+func pitchMixSummary(usage *pitcherUsage, limit int) string {
+	parts := make([]string, 0, limit)
+	for i, pitchType := range usage.types {
+		if i >= limit {
+			break
+		}
+		label := pitchType.code
+		if label == "" {
+			label = pitchType.description
+		}
+		parts = append(parts, fmt.Sprintf("%s %d%%", label, percent(pitchType.count, usage.pitches)))
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, " ")
+}
+
+// End Region: Rendering
+
+var (
+	pitchMixSummaryStyle = lipgloss.NewStyle().Foreground(lipgloss.Yellow).Bold(true)
+	pitchMixEmptyStyle   = lipgloss.NewStyle().Italic(true).Faint(true)
+	bullpenTitleStyle    = lipgloss.NewStyle().Foreground(lipgloss.Magenta).Bold(true).MarginTop(1)
+)
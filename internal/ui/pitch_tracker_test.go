@@ -0,0 +1,177 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func pitch(code, desc string, speed float64, details mlb.PlayEventDetails) mlb.PlayEvent {
+	details.Type = mlb.PlayEventType{Code: code, Description: desc}
+	return mlb.PlayEvent{
+		IsPitch:   true,
+		Details:   details,
+		PitchData: &mlb.PitchData{StartSpeed: speed},
+	}
+}
+
+func TestBuildPitcherUsageAggregatesByPitchType(t *testing.T) {
+	plays := []mlb.Play{
+		{
+			About:   mlb.PlayAbout{Inning: 1, IsTopInning: true},
+			Matchup: mlb.PlayMatchup{Pitcher: mlb.PersonRef{ID: 10}},
+			PlayEvents: []mlb.PlayEvent{
+				pitch("FF", "Four-Seam Fastball", 95, mlb.PlayEventDetails{Code: "C", IsStrike: true}),
+				pitch("FF", "Four-Seam Fastball", 97, mlb.PlayEventDetails{Code: "B", IsBall: true}),
+				pitch("SL", "Slider", 86, mlb.PlayEventDetails{Code: "S", IsStrike: true}),
+				{Details: mlb.PlayEventDetails{Description: "Pickoff attempt"}},
+			},
+		},
+		{
+			About:   mlb.PlayAbout{Inning: 1, IsTopInning: true},
+			Matchup: mlb.PlayMatchup{Pitcher: mlb.PersonRef{ID: 10}},
+			PlayEvents: []mlb.PlayEvent{
+				pitch("FF", "Four-Seam Fastball", 96, mlb.PlayEventDetails{Code: "X", IsInPlay: true}),
+			},
+		},
+		{
+			About:   mlb.PlayAbout{Inning: 1, IsTopInning: false},
+			Matchup: mlb.PlayMatchup{Pitcher: mlb.PersonRef{ID: 20}},
+			PlayEvents: []mlb.PlayEvent{
+				pitch("CU", "Curveball", 78, mlb.PlayEventDetails{Code: "W", IsStrike: true}),
+			},
+		},
+	}
+
+	usages := buildPitcherUsage(plays)
+	if len(usages) != 2 {
+		t.Fatalf("expected 2 pitchers, got %d", len(usages))
+	}
+
+	home := usages[0]
+	if home.id != 10 || !home.isHome {
+		t.Fatalf("expected first pitcher to be home pitcher 10, got %+v", home)
+	}
+	if home.pitches != 4 || home.strikes != 3 || home.whiffs != 1 || home.battersFaced != 2 {
+		t.Fatalf("unexpected totals: %+v", home)
+	}
+	if len(home.types) != 2 || home.types[0].code != "FF" {
+		t.Fatalf("expected fastball to lead the pitch mix, got %+v", home.types)
+	}
+	fastball := home.types[0]
+	if fastball.count != 3 || fastball.veloMax != 97 || fastball.avgVelo() != 96 {
+		t.Fatalf("unexpected fastball aggregation: %+v", fastball)
+	}
+
+	away := findPitcherUsage(usages, 20)
+	if away == nil || away.isHome || away.whiffs != 1 {
+		t.Fatalf("expected away pitcher with one whiff, got %+v", away)
+	}
+	if findPitcherUsage(usages, 99) != nil {
+		t.Fatalf("expected unknown pitcher lookup to return nil")
+	}
+}
+
+func TestBuildPitcherUsageSplitsMidAtBatPitchingChanges(t *testing.T) {
+	fastball := pitch("FF", "Four-Seam Fastball", 95, mlb.PlayEventDetails{Code: "B", IsBall: true})
+	plays := []mlb.Play{
+		{
+			About:      mlb.PlayAbout{Inning: 6, IsTopInning: true},
+			Matchup:    mlb.PlayMatchup{Pitcher: mlb.PersonRef{ID: 10}},
+			PlayEvents: []mlb.PlayEvent{fastball, fastball},
+		},
+		{
+			About:   mlb.PlayAbout{Inning: 6, IsTopInning: true},
+			Matchup: mlb.PlayMatchup{Pitcher: mlb.PersonRef{ID: 11}},
+			PlayEvents: []mlb.PlayEvent{
+				fastball,
+				fastball,
+				{Details: mlb.PlayEventDetails{Event: "Pitching Substitution", EventType: "pitching_substitution"}, Player: &mlb.PersonRef{ID: 11}},
+				pitch("SL", "Slider", 86, mlb.PlayEventDetails{Code: "S", IsStrike: true}),
+			},
+		},
+	}
+
+	usages := buildPitcherUsage(plays)
+	starter, reliever := findPitcherUsage(usages, 10), findPitcherUsage(usages, 11)
+	if starter == nil || reliever == nil {
+		t.Fatalf("expected both pitchers, got %+v", usages)
+	}
+	if starter.pitches != 4 || starter.battersFaced != 1 || len(starter.types) != 1 {
+		t.Fatalf("expected the pitches before the change to stay with the starter, got %+v", starter)
+	}
+	if reliever.pitches != 1 || reliever.strikes != 1 || reliever.battersFaced != 1 || reliever.types[0].code != "SL" {
+		t.Fatalf("expected the reliever to be charged only with the reliever's own pitches, got %+v", reliever)
+	}
+	if !reliever.isHome {
+		t.Fatalf("expected the reliever to pitch for the home club")
+	}
+}
+
+func TestPercentRounds(t *testing.T) {
+	if got := percent(2, 3); got != 67 {
+		t.Fatalf("expected 67, got %d", got)
+	}
+	if got := percent(1, 0); got != 0 {
+		t.Fatalf("expected 0 for empty denominator, got %d", got)
+	}
+}
+
+func TestRenderPitchMixIncludesTypes(t *testing.T) {
+	usage := buildPitcherUsage([]mlb.Play{{
+		Matchup: mlb.PlayMatchup{Pitcher: mlb.PersonRef{ID: 1}},
+		PlayEvents: []mlb.PlayEvent{
+			pitch("SL", "Slider", 85.4, mlb.PlayEventDetails{IsStrike: true}),
+		},
+	}})[0]
+	out := renderPitchMix(usage)
+	if !strings.Contains(out, "Slider") || !strings.Contains(out, "85.4") {
+		t.Fatalf("expected slider row with velocity, got %q", out)
+	}
+	if got := renderPitchMix(nil); !strings.Contains(got, "No pitches") {
+		t.Fatalf("expected empty placeholder, got %q", got)
+	}
+}
+
+func TestRenderPitchMixWithoutPitchTracking(t *testing.T) {
+	usage := buildPitcherUsage([]mlb.Play{{
+		Matchup: mlb.PlayMatchup{Pitcher: mlb.PersonRef{ID: 1}},
+		PlayEvents: []mlb.PlayEvent{
+			{IsPitch: true, Details: mlb.PlayEventDetails{IsStrike: true}},
+			{IsPitch: true, Details: mlb.PlayEventDetails{IsBall: true}},
+		},
+	}})[0]
+	out := renderPitchMix(usage)
+	if !strings.Contains(out, "2 P") || !strings.Contains(out, "not tracked") {
+		t.Fatalf("expected summary with untracked note, got %q", out)
+	}
+	if strings.Contains(out, "Unknown") {
+		t.Fatalf("expected no pitch type table, got %q", out)
+	}
+}
+
+func TestRenderBullpenUsageListsBothTeams(t *testing.T) {
+	usages := []*pitcherUsage{
+		{id: 1, isHome: true, pitches: 10},
+		{id: 2, isHome: false, pitches: 5},
+	}
+	box := mlb.Boxscore{}
+	box.Teams.Home.Players = map[string]mlb.BoxscorePlayer{
+		"ID1": {Person: mlb.PersonInfo{FullName: "Home Arm"}},
+	}
+	box.Teams.Away.Players = map[string]mlb.BoxscorePlayer{
+		"ID2": {Person: mlb.PersonInfo{FullName: "Away Arm"}},
+	}
+	teams := mlb.GameTeams{
+		Home: mlb.GameTeam{Abbreviation: "HME"},
+		Away: mlb.GameTeam{Abbreviation: "AWY"},
+	}
+	out := renderBullpenUsage(usages, box, teams)
+	if !strings.Contains(out, "Home Arm") || !strings.Contains(out, "Away Arm") {
+		t.Fatalf("expected both pitchers listed, got %q", out)
+	}
+	if strings.Index(out, "AWY Pitching") > strings.Index(out, "HME Pitching") {
+		t.Fatalf("expected away club listed first, got %q", out)
+	}
+}
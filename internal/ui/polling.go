@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+// Polling cadences. Live games follow the feed's own wait hint; everything
+// else backs off until there is something worth refreshing for.
+const (
+	liveGameDefaultWait = 10 * time.Second
+	pregameWindow       = 30 * time.Minute
+	pregamePollInterval = 30 * time.Second
+	previewPollInterval = 5 * time.Minute
+	scheduleLiveRefresh = 30 * time.Second
+	minimumPollInterval = 30 * time.Second
+)
+
+// newHubPolicy applies these cadences to the shared polling hub. Only today's
+// schedule is refreshed.
+func newHubPolicy(clock displayClock) mlb.HubPolicy {
+	return mlb.HubPolicy{
+		Game: func(feed *mlb.GameFeed) (time.Duration, bool) {
+			return gamePollInterval(feed, clock.Now())
+		},
+		Schedule: func(date time.Time, resp *mlb.ScheduleResponse) (time.Duration, bool) {
+			if !sameDay(date, clock.Today()) {
+				return 0, false
+			}
+			var games []mlb.ScheduleGame
+			if len(resp.Dates) > 0 {
+				games = resp.Dates[0].Games
+			}
+			return scheduleRefreshInterval(games, clock.Now())
+		},
+	}
+}
+
+// gameOver reports whether a game will not change again today: it is final,
+// or it was postponed or cancelled before it started.
+func gameOver(status mlb.GameStatus) bool {
+	if status.AbstractGameCode == "F" {
+		return true
+	}
+	state := strings.ToLower(status.DetailedState)
+	return strings.Contains(state, "postponed") || strings.Contains(state, "cancelled")
+}
+
+// gamePollInterval decides when a game feed should next be fetched. Finished
+// games are not polled, previews are polled slowly until the pregame window,
+// and live games use the feed's wait hint.
+func gamePollInterval(feed *mlb.GameFeed, now time.Time) (time.Duration, bool) {
+	status := feed.GameData.Status
+	switch {
+	case gameOver(status):
+		return 0, false
+	case status.AbstractGameCode == "P":
+		start, err := time.Parse(time.RFC3339, feed.GameData.Datetime.DateTime)
+		if err != nil || status.StartTimeTBD {
+			return previewPollInterval, true
+		}
+		return untilPregame(start, now), true
+	}
+
+	if feed.MetaData.Wait > 0 {
+		return time.Duration(feed.MetaData.Wait) * time.Second, true
+	}
+	return liveGameDefaultWait, true
+}
+
+// scheduleRefreshInterval decides when a day's schedule should next be
+// reloaded: often while any game is live, slowly until the next first pitch,
+// and not at all once every game is over.
+func scheduleRefreshInterval(games []mlb.ScheduleGame, now time.Time) (time.Duration, bool) {
+	var next time.Time
+	for _, game := range games {
+		switch {
+		case game.Status.AbstractGameCode == "L":
+			return scheduleLiveRefresh, true
+		case gameOver(game.Status):
+		case next.IsZero() || game.GameDate.Before(next):
+			next = game.GameDate
+		}
+	}
+	if next.IsZero() {
+		return 0, false
+	}
+	return untilPregame(next, now), true
+}
+
+// untilPregame waits out the time before a game's pregame window, checking in
+// at least every previewPollInterval in case the start time moves.
+func untilPregame(start, now time.Time) time.Duration {
+	until := start.Sub(now) - pregameWindow
+	if until <= 0 {
+		return pregamePollInterval
+	}
+	return max(min(until, previewPollInterval), minimumPollInterval)
+}
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func TestGamePollInterval(t *testing.T) {
+	now := time.Date(2024, time.June, 12, 16, 0, 0, 0, time.UTC)
+	feed := func(code, state, start string, wait int) *mlb.GameFeed {
+		f := &mlb.GameFeed{}
+		f.GameData.Status = mlb.GameStatus{AbstractGameCode: code, DetailedState: state}
+		f.GameData.Datetime.DateTime = start
+		f.MetaData.Wait = wait
+		return f
+	}
+
+	tests := []struct {
+		name string
+		feed *mlb.GameFeed
+		want time.Duration
+		poll bool
+	}{
+		{"final", feed("F", "Final", "", 10), 0, false},
+		{"postponed", feed("P", "Postponed", "2024-06-12T23:05:00Z", 0), 0, false},
+		{"preview hours away", feed("P", "Scheduled", "2024-06-12T23:05:00Z", 0), previewPollInterval, true},
+		{"preview just outside the window", feed("P", "Scheduled", "2024-06-12T16:30:20Z", 0), minimumPollInterval, true},
+		{"preview inside the window", feed("P", "Pre-Game", "2024-06-12T16:20:00Z", 0), pregamePollInterval, true},
+		{"preview without a start time", feed("P", "Scheduled", "", 0), previewPollInterval, true},
+		{"live with wait", feed("L", "In Progress", "", 5), 5 * time.Second, true},
+		{"live without wait", feed("L", "In Progress", "", 0), liveGameDefaultWait, true},
+	}
+	for _, tt := range tests {
+		got, poll := gamePollInterval(tt.feed, now)
+		if got != tt.want || poll != tt.poll {
+			t.Errorf("%s: gamePollInterval() = %v, %v; want %v, %v", tt.name, got, poll, tt.want, tt.poll)
+		}
+	}
+}
+
+func TestScheduleRefreshInterval(t *testing.T) {
+	now := time.Date(2024, time.June, 12, 16, 0, 0, 0, time.UTC)
+
+	if _, ok := scheduleRefreshInterval([]mlb.ScheduleGame{orderGame(1, "F", 13, 1, 2)}, now); ok {
+		t.Fatalf("expected no refresh once every game is final")
+	}
+	if got, _ := scheduleRefreshInterval([]mlb.ScheduleGame{orderGame(1, "F", 13, 1, 2), orderGame(2, "L", 15, 0, 0), orderGame(3, "P", 23, 0, 0)}, now); got != scheduleLiveRefresh {
+		t.Fatalf("expected live refresh with a game in progress, got %v", got)
+	}
+	if got, _ := scheduleRefreshInterval([]mlb.ScheduleGame{orderGame(3, "P", 23, 0, 0), orderGame(4, "P", 19, 0, 0)}, now); got != previewPollInterval {
+		t.Fatalf("expected slow refresh before the first pitch, got %v", got)
+	}
+	if got, _ := scheduleRefreshInterval([]mlb.ScheduleGame{orderGame(4, "P", 16, 0, 0)}, now); got != pregamePollInterval {
+		t.Fatalf("expected pregame refresh near the first pitch, got %v", got)
+	}
+}
+
+func TestGameIgnoresStaleSubscriptionUpdates(t *testing.T) {
+	gm := GameModel{gameID: 7, requestID: 3, active: true, loading: true}
+
+	gm, cmd := gm.Update(gameLoadedMsg{id: 2, gameID: 7, feed: &mlb.GameFeed{}})
+	if cmd != nil || gm.feed != nil || !gm.loading {
+		t.Fatalf("expected an update from an earlier subscription to be ignored")
+	}
+	gm, _ = gm.Update(gameFailedMsg{id: 2, gameID: 7, err: errors.New("boom")})
+	if gm.err != nil {
+		t.Fatalf("expected a failure from an earlier subscription to be ignored")
+	}
+	gm, _ = gm.Update(gameLoadedMsg{id: 3, gameID: 7, feed: &mlb.GameFeed{}})
+	if gm.feed == nil || gm.loading {
+		t.Fatalf("expected the current subscription's update to be shown")
+	}
+}
+
+func TestGameRetriesFailedGameLogs(t *testing.T) {
+	feed := &mlb.GameFeed{}
+	feed.GameData.Status = mlb.GameStatus{AbstractGameCode: "P"}
+	feed.GameData.ProbablePitchers.Home = &mlb.PersonRef{ID: 42}
+	gm := GameModel{client: mlb.NewClient(), gameID: 7, feed: feed, gameLogs: map[int][]mlb.GameLogSplit{}}
+
+	if cmds := gm.fetchProbableGameLogs(); len(cmds) != 1 {
+		t.Fatalf("expected one game log request, got %d", len(cmds))
+	}
+	if cmds := gm.fetchProbableGameLogs(); len(cmds) != 0 {
+		t.Fatalf("expected no repeat request while one is in flight, got %d", len(cmds))
+	}
+
+	gm, _ = gm.Update(gameLogLoadedMsg{gameID: 7, pitcherID: 42, err: errors.New("boom")})
+	if cmds := gm.fetchProbableGameLogs(); len(cmds) != 1 {
+		t.Fatalf("expected a failed game log to be requested again, got %d", len(cmds))
+	}
+}
@@ -0,0 +1,363 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+
+	"go.dalton.dog/batterup/internal/mlb"
+	"go.dalton.dog/batterup/internal/styles"
+)
+
+// PostseasonModel renders a season's bracket from the wild card round through
+// the World Series. Selecting a series lists its games, which open in the game view.
+type PostseasonModel struct {
+	client   *mlb.Client
+	context  context.Context
+	clock    displayClock
+	requests requestScope
+	// team is the followed club's abbreviation, for picking local broadcasts.
+	team string
+
+	season  int
+	sportID int
+	series  []mlb.PostseasonSeries
+	loading bool
+	err     error
+
+	round         int
+	index         int
+	game          int
+	browsingGames bool
+
+	width  int
+	height int
+}
+
+type postseasonLoadedMsg struct {
+	season  int
+	sportID int
+	series  []mlb.PostseasonSeries
+}
+
+type postseasonFailedMsg struct {
+	season  int
+	sportID int
+	err     error
+}
+
+// openPostseasonMsg instructs the root model to show a level's bracket for a
+// season. A zero SportID shows MLB.
+type openPostseasonMsg struct {
+	Season  int
+	SportID int
+}
+
+var postseasonRoundNames = map[string]string{
+	mlb.GameTypeWildCard:       "Wild Card",
+	mlb.GameTypeDivisionSeries: "Division Series",
+	mlb.GameTypeLeagueSeries:   "Championship Series",
+	mlb.GameTypeWorldSeries:    "World Series",
+}
+
+func NewPostseasonModel(client *mlb.Client, ctx context.Context, clock displayClock, team string) PostseasonModel {
+	return PostseasonModel{
+		client:  client,
+		context: ctx,
+		clock:   clock,
+		team:    team,
+	}
+}
+
+func (p *PostseasonModel) SetSize(width, height int) {
+	p.width = width
+	p.height = height
+}
+
+// SetActive cancels a load in flight when the bracket is left, and retries it
+// when the bracket is shown again.
+func (p *PostseasonModel) SetActive(active bool) tea.Cmd {
+	if active {
+		if requestCancelled(p.err) {
+			return p.setSeason(p.season)
+		}
+		return nil
+	}
+	p.requests.end()
+	if p.loading {
+		p.loading = false
+		p.err = context.Canceled
+	}
+	return nil
+}
+
+// InSubScreen reports whether a series' game list has focus, so esc closes
+// the list instead of leaving the bracket.
+func (p PostseasonModel) InSubScreen() bool {
+	return p.browsingGames
+}
+
+func (p PostseasonModel) Update(msg tea.Msg) (PostseasonModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case openPostseasonMsg:
+		sportID := msg.SportID
+		if sportID == 0 {
+			sportID = mlb.SportMLB
+		}
+		if msg.Season == p.season && sportID == p.sportID && len(p.series) > 0 {
+			return p, nil
+		}
+		p.sportID = sportID
+		return p, p.setSeason(msg.Season)
+	case postseasonLoadedMsg:
+		if msg.season != p.season || msg.sportID != p.sportID {
+			return p, nil
+		}
+		p.loading = false
+		p.err = nil
+		p.series = msg.series
+		p.round, p.index = 0, 0
+		if rounds := p.rounds(); len(rounds[p.round]) == 0 {
+			p.moveRound(1)
+		}
+	case postseasonFailedMsg:
+		if msg.season != p.season || msg.sportID != p.sportID {
+			return p, nil
+		}
+		p.loading = false
+		p.err = msg.err
+	case tea.KeyMsg:
+		if p.browsingGames {
+			return p.updateGames(msg)
+		}
+		switch msg.String() {
+		case "h", "left":
+			p.moveRound(-1)
+		case "l", "right":
+			p.moveRound(1)
+		case "k", "up":
+			p.index = max(p.index-1, 0)
+		case "j", "down":
+			if series := p.rounds()[p.round]; p.index < len(series)-1 {
+				p.index++
+			}
+		case "enter":
+			if selected, ok := p.selectedSeries(); ok && len(selected.Games) > 0 {
+				p.browsingGames = true
+				p.game = 0
+			}
+		case "p", "P":
+			return p, p.setSeason(p.season - 1)
+		case "n", "N":
+			return p, p.setSeason(p.season + 1)
+		}
+	}
+	return p, nil
+}
+
+func (p PostseasonModel) updateGames(msg tea.KeyMsg) (PostseasonModel, tea.Cmd) {
+	selected, ok := p.selectedSeries()
+	if !ok {
+		p.browsingGames = false
+		return p, nil
+	}
+	switch msg.String() {
+	case "esc", "q":
+		p.browsingGames = false
+	case "k", "up":
+		p.game = max(p.game-1, 0)
+	case "j", "down":
+		p.game = min(p.game+1, len(selected.Games)-1)
+	case "enter":
+		game := selected.Games[p.game]
+		broadcasts := relevantBroadcasts(game, p.team)
+		return p, func() tea.Msg { return openGameMsg{GameID: game.GamePk, Broadcasts: broadcasts} }
+	}
+	return p, nil
+}
+
+func (p *PostseasonModel) setSeason(season int) tea.Cmd {
+	p.season = season
+	p.series = nil
+	p.loading = true
+	p.err = nil
+	p.browsingGames = false
+	p.round, p.index = 0, 0
+
+	client := p.client
+	sportID := p.sportID
+	ctx := p.requests.begin(p.context)
+	return func() tea.Msg {
+		series, err := client.FetchPostseason(ctx, season, sportID)
+		if err != nil {
+			return postseasonFailedMsg{season: season, sportID: sportID, err: err}
+		}
+		return postseasonLoadedMsg{season: season, sportID: sportID, series: series}
+	}
+}
+
+// moveRound steps to the next round in the given direction that has any series.
+func (p *PostseasonModel) moveRound(direction int) {
+	rounds := p.rounds()
+	for round := p.round + direction; round >= 0 && round < len(rounds); round += direction {
+		if len(rounds[round]) > 0 {
+			p.round = round
+			p.index = min(p.index, len(rounds[round])-1)
+			return
+		}
+	}
+}
+
+// rounds buckets the series by postseason round, in bracket order.
+func (p PostseasonModel) rounds() [][]mlb.PostseasonSeries {
+	rounds := make([][]mlb.PostseasonSeries, len(mlb.PostseasonRounds))
+	for _, series := range p.series {
+		for idx, round := range mlb.PostseasonRounds {
+			if series.GameType == round {
+				rounds[idx] = append(rounds[idx], series)
+			}
+		}
+	}
+	return rounds
+}
+
+func (p PostseasonModel) selectedSeries() (mlb.PostseasonSeries, bool) {
+	rounds := p.rounds()
+	if p.round >= len(rounds) || p.index >= len(rounds[p.round]) {
+		return mlb.PostseasonSeries{}, false
+	}
+	return rounds[p.round][p.index], true
+}
+
+func (p PostseasonModel) View() string {
+	title := lipgloss.NewStyle().Bold(true).PaddingTop(1).Render(fmt.Sprintf("%d %s Postseason\n<< [P]rev | [N]ext >>", p.season, p.sportName()))
+
+	var body string
+	switch {
+	case requestCancelled(p.err):
+		body = renderCancelled("postseason")
+	case p.err != nil:
+		body = lipgloss.NewStyle().Foreground(lipgloss.Red).Render("Error loading postseason: " + p.err.Error())
+	case p.loading:
+		body = "Loading postseason…"
+	case len(p.series) == 0:
+		body = fmt.Sprintf("No %s postseason games scheduled for %d", p.sportName(), p.season)
+	default:
+		body = p.renderBracket()
+	}
+
+	help := "hjkl to move • enter for games • esc to return"
+	if p.browsingGames {
+		help = "j / k to choose • enter to open game • esc to return to the bracket"
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, title, "", body, styles.HelpTextStyle.Render(help))
+}
+
+// sportName names the bracket's level, as in the schedule's tab bar.
+func (p PostseasonModel) sportName() string {
+	for _, sport := range mlb.Sports {
+		if sport.ID == p.sportID {
+			return sport.Name
+		}
+	}
+	return "MLB"
+}
+
+func (p PostseasonModel) renderBracket() string {
+	rounds := p.rounds()
+	columns := make([]string, 0, len(rounds))
+	for roundIdx, series := range rounds {
+		cells := []string{bracketRoundStyle.Render(postseasonRoundNames[mlb.PostseasonRounds[roundIdx]])}
+		if len(series) == 0 {
+			cells = append(cells, bracketSeriesStyle.Faint(true).Render("TBD"))
+		}
+		for idx, s := range series {
+			style := bracketSeriesStyle
+			if roundIdx == p.round && idx == p.index {
+				style = bracketSelectedStyle
+			}
+			cells = append(cells, style.Render(renderSeriesCell(s, p.clock)))
+		}
+		columns = append(columns, lipgloss.JoinVertical(lipgloss.Center, cells...))
+	}
+
+	bracket := lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+	selected, ok := p.selectedSeries()
+	if !ok {
+		return bracket
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, bracket, "", p.renderSeriesGames(selected))
+}
+
+// renderSeriesCell shows each club's series wins above the series summary,
+// highlighting the winner once the series is over.
+func renderSeriesCell(series mlb.PostseasonSeries, clock displayClock) string {
+	line := func(team mlb.TeamInfo) string {
+		style := styles.ScheduleNeutralTeam
+		if winner, over := series.Winner(); over {
+			style = styles.ScheduleLoserTeam.Faint(true)
+			if winner.ID == team.ID {
+				style = styles.ScheduleWinnerTeam
+			}
+		}
+		return style.Render(fmt.Sprintf("%-4s %d", safeTeam(team.Abbreviation), series.Wins(team.ID)))
+	}
+	return strings.Join([]string{
+		line(series.High),
+		line(series.Low),
+		styles.ScheduleTeamRecord.Render(describeSeries(series, clock)),
+	}, "\n")
+}
+
+// describeSeries summarizes where a series stands, e.g. "NYY leads 2-1". Wins
+// and the result come from the API's series status when it is hydrated.
+func describeSeries(series mlb.PostseasonSeries, clock displayClock) string {
+	high, low := series.Wins(series.High.ID), series.Wins(series.Low.ID)
+	if winner, over := series.Winner(); over {
+		return fmt.Sprintf("%s wins %d-%d", safeTeam(winner.Abbreviation), max(high, low), min(high, low))
+	}
+	switch {
+	case high == 0 && low == 0:
+		if len(series.Games) == 0 {
+			return "Not started"
+		}
+		return "Starts " + clock.In(series.Games[0].GameDate).Format("Jan 2")
+	case high == low:
+		return fmt.Sprintf("Tied %d-%d", high, low)
+	case high > low:
+		return fmt.Sprintf("%s leads %d-%d", safeTeam(series.High.Abbreviation), high, low)
+	default:
+		return fmt.Sprintf("%s leads %d-%d", safeTeam(series.Low.Abbreviation), low, high)
+	}
+}
+
+func (p PostseasonModel) renderSeriesGames(series mlb.PostseasonSeries) string {
+	lines := []string{bracketRoundStyle.Render(series.Description)}
+	for idx, game := range series.Games {
+		summary := summarizeTeamGame(game, series.High.ID, p.clock)
+		line := fmt.Sprintf("Game %d • %s • %s %s %s",
+			idx+1,
+			p.clock.In(game.GameDate).Format("Mon Jan 2"),
+			safeTeam(series.High.Abbreviation),
+			summary.opponent,
+			summary.style.Render(summary.outcome),
+		)
+		if p.browsingGames && idx == p.game {
+			line = bracketGameCursorStyle.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+var (
+	bracketRoundStyle      = lipgloss.NewStyle().Foreground(lipgloss.Yellow).Bold(true)
+	bracketSeriesStyle     = lipgloss.NewStyle().Width(18).Padding(0, 1).Border(lipgloss.HiddenBorder())
+	bracketSelectedStyle   = bracketSeriesStyle.Border(lipgloss.RoundedBorder())
+	bracketGameCursorStyle = lipgloss.NewStyle().Bold(true)
+)
@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func postseasonGame(gamePk int, gameType string, awayID, homeID int, winnerID int) mlb.ScheduleGame {
+	game := teamScheduleGame(awayID, homeID, 0, 0)
+	game.GamePk = gamePk
+	game.GameType = gameType
+	game.GamesInSeries = 3
+	game.GameDate = time.Date(2024, time.October, gamePk, 18, 0, 0, 0, time.UTC)
+	game.Teams.Away.IsWinner = winnerID == awayID
+	game.Teams.Home.IsWinner = winnerID == homeID
+	if winnerID == 0 {
+		game.Status = mlb.GameStatus{AbstractGameCode: "P", DetailedState: "Scheduled"}
+	}
+	return game
+}
+
+func withSeriesStatus(game mlb.ScheduleGame, status mlb.SeriesStatus) mlb.ScheduleGame {
+	game.SeriesStatus = &status
+	return game
+}
+
+func postseasonSeries(gameType string, games ...mlb.ScheduleGame) mlb.PostseasonSeries {
+	return mlb.PostseasonSeries{
+		GameType:    gameType,
+		Description: "Test Series",
+		High:        games[0].Teams.Home.Team,
+		Low:         games[0].Teams.Away.Team,
+		Games:       games,
+		BestOf:      3,
+	}
+}
+
+func TestDescribeSeries(t *testing.T) {
+	tests := []struct {
+		name   string
+		series mlb.PostseasonSeries
+		want   string
+	}{
+		{
+			name:   "not started",
+			series: postseasonSeries(mlb.GameTypeWildCard, postseasonGame(1, "F", 1, 2, 0)),
+			want:   "Starts Oct 1",
+		},
+		{
+			name:   "tied",
+			series: postseasonSeries(mlb.GameTypeWildCard, postseasonGame(1, "F", 1, 2, 2), postseasonGame(2, "F", 1, 2, 1)),
+			want:   "Tied 1-1",
+		},
+		{
+			name:   "low seed leads",
+			series: postseasonSeries(mlb.GameTypeWildCard, postseasonGame(1, "F", 1, 2, 1), postseasonGame(2, "F", 1, 2, 0)),
+			want:   "AWY leads 1-0",
+		},
+		{
+			name:   "clinched",
+			series: postseasonSeries(mlb.GameTypeWildCard, postseasonGame(1, "F", 1, 2, 2), postseasonGame(2, "F", 1, 2, 1), postseasonGame(3, "F", 1, 2, 2)),
+			want:   "HME wins 2-1",
+		},
+		{
+			name: "series status",
+			series: postseasonSeries(mlb.GameTypeWildCard, postseasonGame(1, "F", 1, 2, 2),
+				withSeriesStatus(postseasonGame(2, "F", 1, 2, 2), mlb.SeriesStatus{IsOver: true, Wins: 2, WinningTeam: mlb.TeamRef{ID: 1}, LosingTeam: mlb.TeamRef{ID: 2}})),
+			want: "AWY wins 2-0",
+		},
+		{
+			name: "series status tied",
+			series: postseasonSeries(mlb.GameTypeWildCard,
+				withSeriesStatus(postseasonGame(1, "F", 1, 2, 2), mlb.SeriesStatus{IsTied: true, Wins: 1, Losses: 1})),
+			want: "Tied 1-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := describeSeries(tt.series, displayClock{}); got != tt.want {
+				t.Fatalf("describeSeries() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostseasonNavigationOpensGames(t *testing.T) {
+	p := PostseasonModel{season: 2024, team: "HME", loading: true}
+	upcoming := postseasonGame(6, "D", 3, 4, 0)
+	upcoming.Broadcasts = broadcastGame().Broadcasts
+	p, _ = p.Update(postseasonLoadedMsg{season: 2024, series: []mlb.PostseasonSeries{
+		postseasonSeries(mlb.GameTypeDivisionSeries, postseasonGame(5, "D", 3, 4, 4), upcoming),
+	}})
+	if p.round != 1 {
+		t.Fatalf("expected cursor to skip the empty wild card round, got round %d", p.round)
+	}
+	if view := p.View(); !strings.Contains(view, "Division Series") || !strings.Contains(view, "HME leads 1-0") {
+		t.Fatalf("expected bracket to show the division series\n%s", view)
+	}
+
+	p, _ = p.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !p.InSubScreen() {
+		t.Fatalf("expected enter to focus the series' games")
+	}
+	p, _ = p.Update(keyPress("j"))
+	_, cmd := p.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatalf("expected enter on a game to open it")
+	}
+	msg, ok := cmd().(openGameMsg)
+	if !ok || msg.GameID != 6 {
+		t.Fatalf("expected to open game 6, got %#v", msg)
+	}
+	if got := formatBroadcasts(msg.Broadcasts, true); got != "ESPN, HME Net" {
+		t.Fatalf("expected the followed team's broadcasts, got %q", got)
+	}
+
+	p, _ = p.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if p.InSubScreen() {
+		t.Fatalf("expected esc to return focus to the bracket")
+	}
+}
+
+func TestPostseasonFollowsScheduleLevel(t *testing.T) {
+	p := NewPostseasonModel(nil, nil, displayClock{}, "")
+	p, cmd := p.Update(openPostseasonMsg{Season: 2024, SportID: 11})
+	if cmd == nil || p.sportID != 11 {
+		t.Fatalf("expected the bracket to load the AAA postseason, got sport %d", p.sportID)
+	}
+	if view := p.View(); !strings.Contains(view, "2024 AAA Postseason") {
+		t.Fatalf("expected the title to name the level\n%s", view)
+	}
+
+	series := []mlb.PostseasonSeries{postseasonSeries(mlb.GameTypeWildCard, postseasonGame(1, "F", 1, 2, 2))}
+	p, _ = p.Update(postseasonLoadedMsg{season: 2024, sportID: mlb.SportMLB, series: series})
+	if !p.loading || len(p.series) != 0 {
+		t.Fatalf("expected a bracket for another level to be ignored")
+	}
+	p, _ = p.Update(postseasonLoadedMsg{season: 2024, sportID: 11, series: series})
+
+	if _, cmd := p.Update(openPostseasonMsg{Season: 2024, SportID: 11}); cmd != nil {
+		t.Fatalf("expected the loaded bracket to be reused")
+	}
+	if _, cmd := p.Update(openPostseasonMsg{Season: 2024}); cmd == nil {
+		t.Fatalf("expected switching to MLB to load its bracket")
+	}
+}
@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/lipgloss/v2"
+)
+
+// requestScope hands a view's requests a context it can cancel as soon as the
+// view moves on, so abandoned loads stop instead of running until the client's
+// timeout. The zero value has nothing in flight.
+type requestScope struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// begin cancels the scope's earlier requests and returns a context for the next
+// ones, derived from parent.
+func (r *requestScope) begin(parent context.Context) context.Context {
+	r.end()
+	if parent == nil {
+		parent = context.Background()
+	}
+	r.ctx, r.cancel = context.WithCancel(parent)
+	return r.ctx
+}
+
+// current returns the open context, beginning one if the scope was ended.
+func (r *requestScope) current(parent context.Context) context.Context {
+	if r.ctx == nil {
+		return r.begin(parent)
+	}
+	return r.ctx
+}
+
+// end cancels everything started since the last begin.
+func (r *requestScope) end() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.ctx, r.cancel = nil, nil
+}
+
+// requestCancelled reports whether a request failed because its view
+// cancelled it rather than because anything went wrong.
+func requestCancelled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+// renderCancelled notes a load that was abandoned, without the alarm of an error.
+func renderCancelled(subject string) string {
+	return cancelledStyle.Render("Loading " + subject + " cancelled")
+}
+
+var cancelledStyle = lipgloss.NewStyle().Faint(true)
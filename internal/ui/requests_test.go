@@ -0,0 +1,27 @@
+package ui
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRequestScopeCancelsEarlierRequests(t *testing.T) {
+	var scope requestScope
+	first := scope.begin(context.Background())
+	second := scope.begin(context.Background())
+	if first.Err() == nil || second.Err() != nil {
+		t.Fatalf("expected beginning a new scope to cancel only the earlier one")
+	}
+	if scope.current(context.Background()) != second {
+		t.Fatalf("expected current to reuse the open scope")
+	}
+
+	scope.end()
+	if !requestCancelled(second.Err()) {
+		t.Fatalf("expected end to cancel the open scope, got %v", second.Err())
+	}
+	if ctx := scope.current(context.Background()); ctx.Err() != nil {
+		t.Fatalf("expected current to begin a fresh scope after end")
+	}
+	scope.end()
+}
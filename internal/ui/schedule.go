@@ -9,6 +9,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea/v2"
 	"github.com/charmbracelet/lipgloss/v2"
 
+	"go.dalton.dog/batterup/internal/config"
 	"go.dalton.dog/batterup/internal/mlb"
 	"go.dalton.dog/batterup/internal/styles"
 )
@@ -17,16 +18,53 @@ import (
 // of the MLB schedule. It allows for navigation around
 // the games for that day, if any.
 type ScheduleModel struct {
-	client  *mlb.Client
-	context context.Context
+	client   *mlb.Client
+	hub      *mlb.Hub
+	context  context.Context
+	clock    displayClock
+	requests requestScope
 
 	date     time.Time
+	sport    int
+	gameType int
+	team     string
+	allGames []mlb.ScheduleGame
 	games    []mlb.ScheduleGame
 	loading  bool
 	err      error
 	selected int
+	// selectedPk follows the highlighted game through reloads and reordering.
+	selectedPk int
 
-	grid GridModel
+	// signatures records each game's score and status from the previous load
+	// so changed tiles can flash until flashSeq's timer fires.
+	signatures map[int]string
+	flashing   map[int]bool
+	flashSeq   int
+
+	// status tracks the day's freshness. A failed refresh keeps the day's
+	// games on screen.
+	status feedStatus
+
+	// subscription follows the shown day through the hub, which keeps today's
+	// games refreshed.
+	subscription *mlb.Subscription[*mlb.ScheduleResponse]
+
+	sortMode     int
+	filter       int
+	saveSchedule func(config.Schedule) error
+	saveErr      error
+	// saveSeq counts sort and filter changes, so a burst of them saves only
+	// the last. savedSeq is the latest change handed to saveSchedule.
+	saveSeq  int
+	savedSeq int
+
+	grid   GridModel
+	list   ListModel
+	layout scheduleLayout
+
+	calendar     CalendarModel
+	calendarOpen bool
 
 	width  int
 	height int
@@ -34,17 +72,78 @@ type ScheduleModel struct {
 	active bool
 }
 
+// scheduleLoadedMsg and scheduleFailedMsg carry the subscription that produced
+// them, so only the current one is listened to again.
 type scheduleLoadedMsg struct {
-	date  time.Time
-	games []mlb.ScheduleGame
+	date         time.Time
+	sportID      int
+	gameType     int
+	games        []mlb.ScheduleGame
+	fetchedAt    time.Time
+	nextPoll     time.Time
+	subscription *mlb.Subscription[*mlb.ScheduleResponse]
+}
+
+// scheduleSubscribedMsg hands the model the subscription Init opened.
+type scheduleSubscribedMsg struct {
+	date         time.Time
+	sportID      int
+	gameType     int
+	subscription *mlb.Subscription[*mlb.ScheduleResponse]
 }
 
 type scheduleFailedMsg struct {
-	date time.Time
-	err  error
+	date         time.Time
+	sportID      int
+	gameType     int
+	err          error
+	nextPoll     time.Time
+	subscription *mlb.Subscription[*mlb.ScheduleResponse]
 }
 
-type scheduleAutoRefreshMsg struct{}
+type scheduleFlashDoneMsg struct {
+	seq int
+}
+
+// scheduleFlashDuration is how long a tile stays highlighted after its score or status changes.
+const scheduleFlashDuration = 3 * time.Second
+
+// schedulePrefsSaveDelay is how long the sort and filter must stay put before
+// they are written to the config file.
+const schedulePrefsSaveDelay = 500 * time.Millisecond
+
+type schedulePrefsSaveMsg struct {
+	seq int
+}
+
+type schedulePrefsSavedMsg struct {
+	seq int
+	err error
+}
+
+// scheduleGameType is a choice of which kinds of games the schedule fetches.
+type scheduleGameType struct {
+	label string
+	types []string
+}
+
+var scheduleGameTypes = []scheduleGameType{
+	{label: "All types"},
+	{label: "Regular season", types: []string{mlb.GameTypeRegularSeason}},
+	{label: "Postseason", types: mlb.PostseasonRounds},
+	{label: "Spring training", types: []string{mlb.GameTypeSpringTraining}},
+	{label: "Exhibition", types: []string{mlb.GameTypeExhibition}},
+}
+
+// scheduleLayout chooses between tiles and the compact list. The automatic
+// layout uses the list when the terminal is too narrow for two tiles.
+type scheduleLayout int
+
+const (
+	scheduleLayoutAuto scheduleLayout = iota
+	scheduleLayoutGrid
+	scheduleLayoutList
+)
 
 const (
 	teamColumnMaxWidth = 20
@@ -53,15 +152,27 @@ const (
 	statColumnSpacing  = 1
 )
 
-func NewScheduleModel(client *mlb.Client, ctx context.Context) ScheduleModel {
+func NewScheduleModel(client *mlb.Client, hub *mlb.Hub, ctx context.Context, clock displayClock, opts Options) ScheduleModel {
+	date := opts.Date
+	if date.IsZero() {
+		date = clock.Today()
+	}
 	return ScheduleModel{
-		client:  client,
-		date:    time.Now(),
-		active:  true,
-		loading: true,
-		context: ctx,
+		client: client,
+		hub:    hub,
+		clock:  clock,
+		date:   date,
+		team:   opts.Team,
+
+		sortMode:     sortIndex(opts.Schedule.Sort),
+		filter:       filterIndex(opts.Schedule.Filter),
+		saveSchedule: opts.SaveSchedule,
+		active:       true,
+		loading:      true,
+		context:      ctx,
 
 		grid: NewGridModel(),
+		list: NewListModel("Status", "Away", "Home", "Score", "Inning", "Outs", "Probables"),
 	}
 }
 
@@ -86,19 +197,62 @@ func (s ScheduleModel) teamColumnWidth() int {
 	}
 }
 
+// Init subscribes to the initial day. The subscription arrives as a
+// scheduleSubscribedMsg, since Init can't keep it on the model itself.
 func (s ScheduleModel) Init() tea.Cmd {
-	return s.load()
+	if s.hub == nil {
+		return nil
+	}
+	hub := s.hub
+	date := s.date
+	sportID := s.sportID()
+	gameType := s.gameType
+	return func() tea.Msg {
+		subscription := hub.SubscribeSchedule(date, sportID, scheduleGameTypes[gameType].types)
+		return scheduleSubscribedMsg{date: date, sportID: sportID, gameType: gameType, subscription: subscription}
+	}
 }
 
-func (s *ScheduleModel) SetActive(active bool) {
+// SetActive stops following the day and cancels calendar loads while the
+// schedule is hidden. Updates delivered then would be dropped, so the returned
+// command picks the day back up when the schedule is shown again.
+func (s *ScheduleModel) SetActive(active bool) tea.Cmd {
+	wasActive := s.active
 	s.active = active
+	if !active {
+		s.unsubscribe()
+		s.requests.end()
+		return nil
+	}
+	if wasActive {
+		return nil
+	}
+	return s.subscribe()
 }
 
 func (s *ScheduleModel) SetSize(width, height int) {
 	s.width = width
 	s.height = height
+	s.resizeGrid()
+}
 
-	s.grid.SetSize(width, height-1)
+// resizeGrid gives the grid and list whatever height the header leaves over.
+func (s *ScheduleModel) resizeGrid() {
+	// The header ends in a newline, so its line count is the number of rows it fills.
+	available := s.height - strings.Count(s.header(), "\n") - styles.MainContentWrapperStyle.GetVerticalPadding()
+	s.grid.SetSize(s.width, max(available, 1))
+	s.list.SetSize(s.width, max(available, 1))
+}
+
+// compact reports whether games are shown in the list rather than as tiles.
+func (s ScheduleModel) compact() bool {
+	switch s.layout {
+	case scheduleLayoutGrid:
+		return false
+	case scheduleLayoutList:
+		return true
+	}
+	return s.width > 0 && s.grid.itemWidth > 0 && s.width < 2*(s.grid.itemWidth+2)
 }
 
 func (s ScheduleModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -108,115 +262,452 @@ func (s ScheduleModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return s, nil
 		}
 
+		if s.calendarOpen {
+			return s.updateCalendar(msg)
+		}
+
 		switch msg.String() {
+		case "tab":
+			s.sport = (s.sport + 1) % len(mlb.Sports)
+			return s, s.setDate(s.date)
+		case "shift+tab":
+			s.sport = (s.sport + len(mlb.Sports) - 1) % len(mlb.Sports)
+			return s, s.setDate(s.date)
+		case "y":
+			s.gameType = (s.gameType + 1) % len(scheduleGameTypes)
+			return s, s.setDate(s.date)
+		case "Y":
+			s.gameType = (s.gameType + len(scheduleGameTypes) - 1) % len(scheduleGameTypes)
+			return s, s.setDate(s.date)
+		case "c", "C":
+			s.calendar = NewCalendarModel(s.date, s.clock)
+			s.calendar.loading = true
+			s.calendarOpen = true
+			return s, s.loadCalendarMonth(s.calendar.Month())
 		case "enter":
 			if s.loading || len(s.games) == 0 {
 				return s, nil
 			}
-			idx := s.grid.GetIndex()
+			idx := s.selected
 			if idx < 0 || idx >= len(s.games) {
 				return s, nil
 			}
-			s.selected = idx
-			gameID := s.games[idx].GamePk
-			return s, func() tea.Msg { return openGameMsg{GameID: gameID} }
+			game := s.games[idx]
+			broadcasts := relevantBroadcasts(game, s.team)
+			return s, func() tea.Msg { return openGameMsg{GameID: game.GamePk, Broadcasts: broadcasts} }
+		case "a", "A", "s", "S":
+			if s.loading || len(s.games) == 0 {
+				return s, nil
+			}
+			idx := s.selected
+			if idx < 0 || idx >= len(s.games) {
+				return s, nil
+			}
+			team := s.games[idx].Teams.Home.Team
+			if key := msg.String(); key == "a" || key == "A" {
+				team = s.games[idx].Teams.Away.Team
+			}
+			date := s.date
+			sportID := s.sportID()
+			return s, func() tea.Msg { return openTeamScheduleMsg{Team: team, SportID: sportID, Date: date} }
+		case "o":
+			s.sortMode = (s.sortMode + 1) % len(scheduleSorts)
+			return s, s.arrangeChanged()
+		case "O":
+			s.sortMode = (s.sortMode + len(scheduleSorts) - 1) % len(scheduleSorts)
+			return s, s.arrangeChanged()
+		case "f":
+			s.filter = s.nextFilter(1)
+			return s, s.arrangeChanged()
+		case "F":
+			s.filter = s.nextFilter(-1)
+			return s, s.arrangeChanged()
+		case "v", "V":
+			if s.compact() {
+				s.layout = scheduleLayoutGrid
+			} else {
+				s.layout = scheduleLayoutList
+			}
+			return s, nil
+		case "b", "B":
+			season := s.date.Year()
+			sportID := s.sportID()
+			return s, func() tea.Msg { return openPostseasonMsg{Season: season, SportID: sportID} }
 		case "p", "P":
-			s.date = s.date.AddDate(0, 0, -1)
-			s.selected = 0
-			s.grid.SetCursor(0)
-			s.loading = true
-			s.err = nil
-			return s, s.load()
+			return s, s.setDate(s.date.AddDate(0, 0, -1))
 		case "n", "N":
-			s.date = s.date.AddDate(0, 0, 1)
-			s.selected = 0
-			s.grid.SetCursor(0)
-			s.loading = true
-			s.err = nil
-			return s, s.load()
+			return s, s.setDate(s.date.AddDate(0, 0, 1))
+		case "[":
+			return s, s.setDate(s.date.AddDate(0, 0, -7))
+		case "]":
+			return s, s.setDate(s.date.AddDate(0, 0, 7))
 		case "t", "T":
-			today := time.Now()
-			s.date = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
-			s.selected = 0
-			s.grid.SetCursor(0)
-			s.loading = true
-			s.err = nil
-			return s, s.load()
-
+			return s, s.setDate(s.clock.Today())
+		case "q":
+			// Write a sort or filter change that is still settling before quitting.
+			return s, tea.Sequence(s.savePrefs(), tea.Quit)
 		}
+	case calendarCountsMsg:
+		s.calendar.SetCounts(msg.month, msg.counts)
+		return s, nil
+	case calendarFailedMsg:
+		s.calendar.SetError(msg.month, msg.err)
+		return s, nil
+	case scheduleSubscribedMsg:
+		// The day, sport, game type or visibility may have changed while it
+		// was opening, in which case a newer subscription already took over.
+		if s.subscription != nil || !s.active || !s.showing(msg.date, msg.sportID, msg.gameType) {
+			msg.subscription.Close()
+			return s, nil
+		}
+		s.subscription = msg.subscription
+		return s, s.waitForUpdate()
 	case scheduleLoadedMsg:
-		if !sameDay(msg.date, s.date) {
+		if !s.showing(msg.date, msg.sportID, msg.gameType) {
 			return s, nil
 		}
 		s.loading = false
 		s.err = nil
-		s.games = msg.games
-		if s.selected >= len(s.games) {
-			s.selected = max(len(s.games)-1, 0)
+		s.status.succeeded(msg.fetchedAt, msg.nextPoll)
+		s.allGames = msg.games
+		s.flashing = changedGames(s.signatures, msg.games)
+		s.signatures = gameSignatures(msg.games)
+		s.rebuild()
+
+		refresh := s.listenAgain(msg.subscription)
+		if len(s.flashing) == 0 {
+			return s, refresh
 		}
-		items := make([]GridItem, len(s.games))
-		for idx, game := range s.games {
-			items[idx] = GridItem(s.renderGame(game))
+		s.flashSeq++
+		seq := s.flashSeq
+		return s, tea.Batch(refresh, tea.Tick(scheduleFlashDuration, func(time.Time) tea.Msg { return scheduleFlashDoneMsg{seq: seq} }))
+	case scheduleFlashDoneMsg:
+		if msg.seq == s.flashSeq {
+			s.flashing = nil
+			s.grid.SetFlashing(nil)
 		}
-		s.grid.SetItems(items)
-		s.grid.SetCursor(s.selected)
-		return s, tea.Tick(30*time.Second, func(time.Time) tea.Msg { return scheduleAutoRefreshMsg{} })
+		return s, nil
 	case scheduleFailedMsg:
-		if !sameDay(msg.date, s.date) {
+		if !s.showing(msg.date, msg.sportID, msg.gameType) {
 			return s, nil
 		}
 		s.loading = false
 		s.err = msg.err
-	case scheduleAutoRefreshMsg:
-		if s.viewingToday() {
-			s.loading = true
-			s.err = nil
-			return s, s.load()
+		s.status.failed(msg.nextPoll)
+		return s, s.listenAgain(msg.subscription)
+	case schedulePrefsSaveMsg:
+		if msg.seq != s.saveSeq {
+			return s, nil
 		}
+		return s, s.savePrefs()
+	case schedulePrefsSavedMsg:
+		if msg.seq != s.saveSeq {
+			return s, nil
+		}
+		s.saveErr = msg.err
+		s.resizeGrid()
 		return s, nil
 	}
 
 	var cmd tea.Cmd
-	s.grid, cmd = s.grid.Update(msg)
-	s.selected = s.grid.GetIndex()
+	if s.compact() {
+		s.list, cmd = s.list.Update(msg)
+		s.selected = s.list.GetIndex()
+	} else {
+		s.grid, cmd = s.grid.Update(msg)
+		s.selected = s.grid.GetIndex()
+	}
+	// Both layouts follow the same game so switching keeps the selection.
+	s.grid.SetCursor(s.selected)
+	s.list.SetCursor(s.selected)
+	if s.selected < len(s.games) {
+		s.selectedPk = s.games[s.selected].GamePk
+	}
 	return s, cmd
 }
 
-func (s *ScheduleModel) viewingToday() bool {
-	return time.Now().Format("2006-01-02") == s.date.Format("2006-01-02")
+// rebuild filters, sorts and groups the loaded games into grid tiles, keeping
+// the cursor on the same game when it is still shown.
+func (s *ScheduleModel) rebuild() {
+	arranged := arrangeGames(s.allGames, scheduleSorts[s.sortMode], s.activeFilter(), s.team)
+
+	var groups []int
+	s.games, groups = groupDoubleHeaders(arranged)
+
+	for idx, game := range s.games {
+		if game.GamePk == s.selectedPk {
+			s.selected = idx
+			break
+		}
+	}
+	if s.selected >= len(s.games) {
+		s.selected = max(len(s.games)-1, 0)
+	}
+	if s.selected < len(s.games) {
+		s.selectedPk = s.games[s.selected].GamePk
+	}
+
+	items := make([]GridItem, len(s.games))
+	rows := make([][]string, len(s.games))
+	for idx, game := range s.games {
+		items[idx] = GridItem(s.renderGame(game))
+		rows[idx] = scheduleListRow(game, s.clock)
+	}
+	s.grid.SetItems(items)
+	s.grid.SetGroups(groups)
+	s.grid.SetCursor(s.selected)
+	s.list.SetRows(rows)
+	s.list.SetCursor(s.selected)
+
+	flashing := make(map[int]bool)
+	for idx, game := range s.games {
+		if s.flashing[game.GamePk] {
+			flashing[idx] = true
+		}
+	}
+	s.grid.SetFlashing(flashing)
 }
 
-func (s ScheduleModel) load() tea.Cmd {
-	date := s.date
+// gameSignature captures what a schedule tile shows that can change mid-game.
+func gameSignature(game mlb.ScheduleGame) string {
+	signature := game.Status.AbstractGameCode + "|" + game.Status.DetailedState
+	if linescore := game.Linescore; linescore != nil {
+		signature += fmt.Sprintf("|%d-%d|%s %d",
+			linescore.Teams.Away.Runs, linescore.Teams.Home.Runs,
+			linescore.InningState, linescore.CurrentInning)
+	}
+	return signature
+}
+
+func gameSignatures(games []mlb.ScheduleGame) map[int]string {
+	signatures := make(map[int]string, len(games))
+	for _, game := range games {
+		signatures[game.GamePk] = gameSignature(game)
+	}
+	return signatures
+}
+
+// changedGames lists games whose tile differs from the previous load. Games
+// that were not in the previous load are not considered changed.
+func changedGames(previous map[int]string, games []mlb.ScheduleGame) map[int]bool {
+	changed := make(map[int]bool)
+	for _, game := range games {
+		if before, ok := previous[game.GamePk]; ok && before != gameSignature(game) {
+			changed[game.GamePk] = true
+		}
+	}
+	return changed
+}
+
+// arrangeChanged re-arranges the current games after a sort or filter change
+// and saves the new modes once they settle.
+func (s *ScheduleModel) arrangeChanged() tea.Cmd {
+	s.rebuild()
+	if s.saveSchedule == nil {
+		return nil
+	}
+	s.saveSeq++
+	seq := s.saveSeq
+	return tea.Tick(schedulePrefsSaveDelay, func(time.Time) tea.Msg { return schedulePrefsSaveMsg{seq: seq} })
+}
+
+// savePrefs writes the current sort and filter if a change to them hasn't been
+// saved yet.
+func (s *ScheduleModel) savePrefs() tea.Cmd {
+	if s.saveSchedule == nil || s.savedSeq == s.saveSeq {
+		return nil
+	}
+	s.savedSeq = s.saveSeq
+	save := s.saveSchedule
+	seq := s.saveSeq
+	prefs := config.Schedule{Sort: scheduleSorts[s.sortMode].key, Filter: scheduleFilters[s.filter].key}
 	return func() tea.Msg {
-		resp, err := s.client.FetchSchedule(s.context, date)
+		return schedulePrefsSavedMsg{seq: seq, err: save(prefs)}
+	}
+}
+
+// setDate moves the schedule to a new day and starts loading it.
+func (s *ScheduleModel) setDate(date time.Time) tea.Cmd {
+	s.date = date
+	s.selected = 0
+	s.selectedPk = 0
+	s.signatures = nil
+	s.flashing = nil
+	s.status = feedStatus{}
+	s.grid.SetCursor(0)
+	s.list.SetCursor(0)
+	s.loading = true
+	s.err = nil
+	return s.subscribe()
+}
+
+func (s ScheduleModel) updateCalendar(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "c", "C":
+		s.calendarOpen = false
+		s.requests.end()
+		return s, nil
+	case "enter":
+		s.calendarOpen = false
+		s.requests.end()
+		if sameDay(s.calendar.Cursor(), s.date) {
+			return s, nil
+		}
+		return s, s.setDate(s.calendar.Cursor())
+	}
+
+	var monthChanged bool
+	s.calendar, monthChanged = s.calendar.Update(msg)
+	if monthChanged {
+		return s, s.loadCalendarMonth(s.calendar.Month())
+	}
+	return s, nil
+}
+
+// sportID is the StatsAPI sport currently selected in the tab bar.
+func (s ScheduleModel) sportID() int {
+	return mlb.Sports[s.sport].ID
+}
+
+// activeFilter is the filter applied to the day. League and division filters
+// only apply to MLB, so other levels show every game while keeping the choice
+// for when MLB is selected again.
+func (s ScheduleModel) activeFilter() scheduleFilter {
+	if filter := scheduleFilters[s.filter]; !filter.mlbOnly || s.sportID() == mlb.SportMLB {
+		return filter
+	}
+	return scheduleFilters[0]
+}
+
+// nextFilter steps from the active filter in the given direction, skipping
+// filters the selected level can't use.
+func (s ScheduleModel) nextFilter(direction int) int {
+	idx := s.filter
+	if s.activeFilter().key != scheduleFilters[idx].key {
+		idx = 0
+	}
+	for range scheduleFilters {
+		idx = (idx + direction + len(scheduleFilters)) % len(scheduleFilters)
+		if !scheduleFilters[idx].mlbOnly || s.sportID() == mlb.SportMLB {
+			break
+		}
+	}
+	return idx
+}
+
+// showing reports whether a load for the given day, sport and game type is
+// still the one on screen.
+func (s ScheduleModel) showing(date time.Time, sportID, gameType int) bool {
+	return sameDay(date, s.date) && sportID == s.sportID() && gameType == s.gameType
+}
+
+// loadCalendarMonth counts the month's games, cancelling the previous month's
+// load if it is still running.
+func (s *ScheduleModel) loadCalendarMonth(month time.Time) tea.Cmd {
+	client := s.client
+	ctx := s.requests.begin(s.context)
+	sportID := s.sportID()
+	gameTypes := scheduleGameTypes[s.gameType].types
+	return func() tea.Msg {
+		end := month.AddDate(0, 1, -1)
+		resp, err := client.FetchSchedule(ctx, mlb.ScheduleQuery{StartDate: month, EndDate: end, SportID: sportID, GameTypes: gameTypes, Hydrate: []string{"team"}})
 		if err != nil {
-			return scheduleFailedMsg{date: date, err: err}
+			return calendarFailedMsg{month: month, err: err}
+		}
+		return calendarCountsMsg{month: month, counts: countGamesByDay(resp)}
+	}
+}
+
+// subscribe follows the current day, sport and game type, dropping the previous
+// subscription.
+func (s *ScheduleModel) subscribe() tea.Cmd {
+	s.unsubscribe()
+	if s.hub == nil {
+		return nil
+	}
+	s.subscription = s.hub.SubscribeSchedule(s.date, s.sportID(), scheduleGameTypes[s.gameType].types)
+	return s.waitForUpdate()
+}
+
+func (s *ScheduleModel) unsubscribe() {
+	if s.subscription != nil {
+		s.subscription.Close()
+		s.subscription = nil
+	}
+}
+
+// listenAgain waits for the next update after one from sub has been handled.
+// Updates from earlier subscriptions don't re-arm anything.
+func (s ScheduleModel) listenAgain(sub *mlb.Subscription[*mlb.ScheduleResponse]) tea.Cmd {
+	if sub == nil || sub != s.subscription {
+		return nil
+	}
+	return s.waitForUpdate()
+}
+
+// waitForUpdate delivers the subscription's next update as a scheduleLoadedMsg
+// or scheduleFailedMsg. It returns nothing once the subscription is closed.
+func (s ScheduleModel) waitForUpdate() tea.Cmd {
+	sub := s.subscription
+	if sub == nil {
+		return nil
+	}
+	date := s.date
+	sportID := s.sportID()
+	gameType := s.gameType
+	return func() tea.Msg {
+		update, ok := <-sub.Updates()
+		if !ok {
+			return nil
+		}
+		if update.Err != nil {
+			return scheduleFailedMsg{date: date, sportID: sportID, gameType: gameType, err: update.Err, nextPoll: update.NextPoll, subscription: sub}
 		}
 		games := []mlb.ScheduleGame{}
-		if len(resp.Dates) > 0 {
-			games = resp.Dates[0].Games
+		if len(update.Value.Dates) > 0 {
+			games = update.Value.Dates[0].Games
 		}
-		return scheduleLoadedMsg{date: date, games: games}
+		return scheduleLoadedMsg{date: date, sportID: sportID, gameType: gameType, games: games, fetchedAt: update.FetchedAt, nextPoll: update.NextPoll, subscription: sub}
 	}
 }
 
+// sameDay compares calendar dates in a's timezone. Callers pass days from the
+// display clock, so that is the display timezone.
 func sameDay(a, b time.Time) bool {
+	b = b.In(a.Location())
 	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
 }
 
-func (s ScheduleModel) View() string {
+// header renders everything above the games: level tabs, date, key hints and
+// the active game type, sort and filter.
+func (s ScheduleModel) header() string {
 	var builder strings.Builder
-	builder.WriteString(lipgloss.NewStyle().Bold(true).AlignHorizontal(lipgloss.Center).PaddingTop(1).Render(s.date.Format("Monday, January 2, 2006") + "\n<< [P]rev | [T]oday | [N]ext >>"))
+	builder.WriteString(s.renderSportTabs())
+	builder.WriteString("\n")
+	builder.WriteString(lipgloss.NewStyle().Bold(true).AlignHorizontal(lipgloss.Center).PaddingTop(1).Render(s.date.Format("Monday, January 2, 2006") + "\n<< [P]rev | [T]oday | [N]ext >>\n[ / ] week • [C]alendar • [B]racket • [V]iew tiles / list\nA / S away / home team schedule • tab to change level"))
+	builder.WriteString("\n")
+	builder.WriteString(s.renderArrangement())
 	builder.WriteString("\n\n")
+	return builder.String()
+}
+
+func (s ScheduleModel) View() string {
+	var builder strings.Builder
+	builder.WriteString(s.header())
 
 	switch {
+	case s.calendarOpen:
+		builder.WriteString(s.calendar.View())
 	case s.loading && len(s.games) == 0:
 		builder.WriteString("Loading schedule…")
-	case s.err != nil:
+	case s.err != nil && s.status.updated.IsZero():
 		builder.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("red")).Render("Error loading schedule: " + s.err.Error()))
+	case len(s.allGames) > 0 && len(s.games) == 0:
+		builder.WriteString(fmt.Sprintf("No games match the %s filter", s.activeFilter().label))
 	case len(s.games) == 0:
 		builder.WriteString("No games scheduled")
+	case s.compact():
+		builder.WriteString(s.list.View())
 	default:
 		builder.WriteString(s.grid.View())
 	}
@@ -224,6 +715,29 @@ func (s ScheduleModel) View() string {
 	return builder.String()
 }
 
+// renderArrangement describes the active game type, sort and filter modes.
+func (s ScheduleModel) renderArrangement() string {
+	line := scheduleArrangementStyle.Render(fmt.Sprintf("T[y]pe: %s • [O]rder: %s • [F]ilter: %s",
+		scheduleGameTypes[s.gameType].label, scheduleSorts[s.sortMode].label, s.activeFilter().label))
+	if s.saveErr != nil {
+		line += "\n" + lipgloss.NewStyle().Foreground(lipgloss.Red).Render("Could not save preferences: "+s.saveErr.Error())
+	}
+	return line
+}
+
+// renderSportTabs shows the selectable levels with the current one highlighted.
+func (s ScheduleModel) renderSportTabs() string {
+	tabs := make([]string, 0, len(mlb.Sports))
+	for idx, sport := range mlb.Sports {
+		style := scheduleSportTabStyle
+		if idx == s.sport {
+			style = scheduleSportActiveStyle
+		}
+		tabs = append(tabs, style.Render(sport.Name))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, tabs...)
+}
+
 func (s ScheduleModel) renderGame(game mlb.ScheduleGame) string {
 	linescore := game.Linescore
 	awayRuns, awayHits, awayErrors := "-", "-", "-"
@@ -251,7 +765,7 @@ func (s ScheduleModel) renderGame(game mlb.ScheduleGame) string {
 	teamColumnWidth := s.teamColumnWidth()
 
 	statusStyle := scheduleStatusStyle(game)
-	status := statusStyle.Render(describeGameStatus(game))
+	status := statusStyle.Render(describeGameStatus(game, s.clock))
 
 	header := renderScheduleHeader(teamColumnWidth)
 	awayRow := renderScheduleRow("  ", teamColumnWidth, game.Teams.Away, awayStyle, awayRuns, awayHits, awayErrors)
@@ -264,13 +778,223 @@ func (s ScheduleModel) renderGame(game mlb.ScheduleGame) string {
 		homeRow,
 	)
 
-	return lipgloss.JoinVertical(
-		lipgloss.Center,
-		status,
-		rows,
+	parts := []string{status}
+	if notes := scheduleNotes(game, s.clock); notes != "" {
+		parts = append(parts, styles.ScheduleTeamRecord.Render(notes))
+	}
+	parts = append(parts, rows)
+	switch game.Status.AbstractGameCode {
+	case "P":
+		if probables := renderProbables(game.Teams.Away.ProbablePitcher, game.Teams.Home.ProbablePitcher, lipgloss.Width(rows)); probables != "" {
+			parts = append(parts, probables)
+		}
+	case "L":
+		if linescore != nil {
+			parts = append(parts, renderLiveSituation(*linescore, lipgloss.Width(rows)))
+		}
+	case "F":
+		if decisions := formatDecisions(game.Decisions); decisions != "" {
+			parts = append(parts, scheduleMatchupStyle.Render(truncateText(decisions, lipgloss.Width(rows))))
+		}
+	}
+	if tv := formatBroadcasts(relevantBroadcasts(game, s.team), true); tv != "" {
+		tileWidth := lipgloss.Width(rows)
+		parts = append(parts, scheduleBroadcastStyle.Render(truncateText("TV: "+tv, tileWidth)))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center, parts...)
+}
+
+// renderLiveSituation shows the runners, count and outs of a live game next to
+// the current batter and pitcher, fitted to a tile's width.
+func renderLiveSituation(linescore mlb.GameLineScore, width int) string {
+	count := strings.Join([]string{
+		fmt.Sprintf("%d-%d", linescore.Balls, linescore.Strikes),
+		bullet(linescore.Outs, 3, styles.OutColor),
+	}, "\n")
+	situation := lipgloss.JoinHorizontal(lipgloss.Center, renderBases(linescore.Offense), "   ", count)
+
+	var matchup []string
+	if pitcher := linescore.Defense.Pitcher; pitcher != nil && pitcher.FullName != "" {
+		matchup = append(matchup, "P: "+shortName(pitcher.FullName))
+	}
+	if batter := linescore.Offense.Batter; batter != nil && batter.FullName != "" {
+		matchup = append(matchup, "AB: "+shortName(batter.FullName))
+	}
+	if len(matchup) == 0 {
+		return situation
+	}
+	return lipgloss.JoinVertical(lipgloss.Center,
+		situation,
+		scheduleMatchupStyle.Render(truncateText(strings.Join(matchup, " • "), width)),
 	)
 }
 
+// renderProbables lists the scheduled starters with their season records, e.g.
+// "Cole (12-4, 3.01) vs Bello (9-8, 4.20)", on two lines when one is too wide.
+func renderProbables(away, home *mlb.SchedulePitcher, width int) string {
+	if away == nil && home == nil {
+		return ""
+	}
+	awayLine, homeLine := describeProbable(away), describeProbable(home)
+	if line := awayLine + " vs " + homeLine; lipgloss.Width(line) <= width {
+		return scheduleMatchupStyle.Render(line)
+	}
+	return scheduleMatchupStyle.Render(truncateText(awayLine, width) + "\n" + truncateText("vs "+homeLine, width))
+}
+
+func describeProbable(pitcher *mlb.SchedulePitcher) string {
+	if pitcher == nil || pitcher.FullName == "" {
+		return "TBD"
+	}
+	season, ok := pitcher.Season()
+	if !ok {
+		return surname(pitcher.FullName)
+	}
+	return fmt.Sprintf("%s (%d-%d, %s)", surname(pitcher.FullName), season.Wins, season.Losses, statOrDash(season.ERA))
+}
+
+// formatDecisions names the pitchers of record, e.g. "W: Kirby  L: Cole  S: Muñoz".
+func formatDecisions(decisions *mlb.Decisions) string {
+	if decisions == nil {
+		return ""
+	}
+	var parts []string
+	for _, decision := range []struct {
+		label  string
+		person *mlb.PersonRef
+	}{
+		{"W", decisions.Winner},
+		{"L", decisions.Loser},
+		{"S", decisions.Save},
+	} {
+		if decision.person != nil && decision.person.FullName != "" {
+			parts = append(parts, decision.label+": "+surname(decision.person.FullName))
+		}
+	}
+	return strings.Join(parts, "  ")
+}
+
+// surname drops a full name's first name, keeping suffixes such as "Jr.".
+func surname(name string) string {
+	parts := strings.Fields(name)
+	if len(parts) < 2 {
+		return safeName(name)
+	}
+	return strings.Join(parts[1:], " ")
+}
+
+// scheduleListRow condenses a game into the compact list's columns.
+func scheduleListRow(game mlb.ScheduleGame, clock displayClock) []string {
+	status := game.Status.DetailedState
+	switch game.Status.AbstractGameCode {
+	case "P":
+		switch {
+		case game.Status.DetailedState != "" && game.Status.DetailedState != "Scheduled" && game.Status.DetailedState != "Pre-Game":
+			status = game.Status.DetailedState
+		case game.Status.StartTimeTBD:
+			status = "TBD"
+		default:
+			status = clock.In(game.GameDate).Format("3:04 PM")
+		}
+	case "L":
+		status = "Live"
+	}
+
+	var score, inning, outs string
+	if linescore := game.Linescore; linescore != nil && game.Status.AbstractGameCode != "P" {
+		score = fmt.Sprintf("%d-%d", linescore.Teams.Away.Runs, linescore.Teams.Home.Runs)
+		if game.Status.AbstractGameCode == "L" {
+			inning = strings.TrimSpace(linescore.InningState + " " + linescore.CurrentInningOrdinal)
+			outs = bullet(linescore.Outs, 3, styles.OutColor)
+		}
+	}
+
+	return []string{
+		scheduleStatusStyle(game).Render(status),
+		safeTeam(game.Teams.Away.Team.Abbreviation),
+		safeTeam(game.Teams.Home.Team.Abbreviation),
+		score,
+		inning,
+		outs,
+		formatProbables(game.Teams.Away.ProbablePitcher, game.Teams.Home.ProbablePitcher),
+	}
+}
+
+// formatProbables names both scheduled starters, e.g. "G. Cole vs B. Bello".
+func formatProbables(away, home *mlb.SchedulePitcher) string {
+	if away == nil && home == nil {
+		return ""
+	}
+	name := func(person *mlb.SchedulePitcher) string {
+		if person == nil || person.FullName == "" {
+			return "TBD"
+		}
+		return shortName(person.FullName)
+	}
+	return name(away) + " vs " + name(home)
+}
+
+// scheduleNotes explains why a game may look out of place: which half of a
+// doubleheader it is, or where a suspended or postponed game continues.
+func scheduleNotes(game mlb.ScheduleGame, clock displayClock) string {
+	var notes []string
+	switch game.DoubleHeader {
+	case mlb.DoubleHeaderTraditional:
+		notes = append(notes, fmt.Sprintf("Doubleheader G%d", game.GameNumber))
+	case mlb.DoubleHeaderSplit:
+		notes = append(notes, fmt.Sprintf("Split DH G%d", game.GameNumber))
+	}
+	if !game.ResumedFrom.IsZero() {
+		notes = append(notes, "Resumed from "+clock.In(game.ResumedFrom).Format("1/2"))
+	}
+	if !game.ResumeDate.IsZero() {
+		notes = append(notes, "Resumes "+clock.In(game.ResumeDate).Format("1/2"))
+	}
+	if !game.RescheduleDate.IsZero() && game.ResumeDate.IsZero() {
+		notes = append(notes, "Rescheduled to "+clock.In(game.RescheduleDate).Format("1/2"))
+	}
+	return strings.Join(notes, " • ")
+}
+
+// groupDoubleHeaders moves both halves of each doubleheader next to each other,
+// keeping the first game's place, and returns a grid group for every game.
+func groupDoubleHeaders(games []mlb.ScheduleGame) ([]mlb.ScheduleGame, []int) {
+	type matchup struct{ away, home int }
+
+	ordered := make([]mlb.ScheduleGame, 0, len(games))
+	groups := make([]int, 0, len(games))
+	placed := make([]bool, len(games))
+	nextGroup := 1
+	for idx, game := range games {
+		if placed[idx] {
+			continue
+		}
+		placed[idx] = true
+		if !game.IsDoubleHeader() {
+			ordered = append(ordered, game)
+			groups = append(groups, 0)
+			continue
+		}
+
+		key := matchup{away: game.Teams.Away.Team.ID, home: game.Teams.Home.Team.ID}
+		ordered = append(ordered, game)
+		groups = append(groups, nextGroup)
+		for other := idx + 1; other < len(games); other++ {
+			candidate := games[other]
+			if placed[other] || !candidate.IsDoubleHeader() ||
+				(matchup{away: candidate.Teams.Away.Team.ID, home: candidate.Teams.Home.Team.ID}) != key {
+				continue
+			}
+			placed[other] = true
+			ordered = append(ordered, candidate)
+			groups = append(groups, nextGroup)
+		}
+		nextGroup++
+	}
+	return ordered, groups
+}
+
 func scheduleStatusStyle(game mlb.ScheduleGame) lipgloss.Style {
 	switch game.Status.AbstractGameCode {
 	case "P":
@@ -290,16 +1014,13 @@ func scheduleStatusStyle(game mlb.ScheduleGame) lipgloss.Style {
 	return styles.ScheduleStatusUpcoming
 }
 
-func describeGameStatus(game mlb.ScheduleGame) string {
+func describeGameStatus(game mlb.ScheduleGame, clock displayClock) string {
 	switch game.Status.AbstractGameCode {
 	case "P":
-		if game.DoubleHeader == "Y" && game.GameNumber > 1 {
-			return fmt.Sprintf("Game %d", game.GameNumber)
-		}
 		if game.Status.StartTimeTBD {
 			return "Start time TBD"
 		}
-		return game.GameDate.Local().Format("Starts @ 3:04 PM MST")
+		return clock.In(game.GameDate).Format("Starts @ 3:04 PM MST")
 	case "L":
 		if game.Linescore != nil {
 			state := strings.TrimSpace(game.Linescore.InningState + " " + game.Linescore.CurrentInningOrdinal)
@@ -449,3 +1170,11 @@ func columnGap() string {
 	}
 	return strings.Repeat(" ", statColumnSpacing)
 }
+
+var (
+	scheduleArrangementStyle = lipgloss.NewStyle().Foreground(lipgloss.Cyan)
+	scheduleBroadcastStyle   = lipgloss.NewStyle().Faint(true)
+	scheduleMatchupStyle     = lipgloss.NewStyle().Foreground(lipgloss.Cyan)
+	scheduleSportTabStyle    = lipgloss.NewStyle().Padding(0, 1).Faint(true)
+	scheduleSportActiveStyle = lipgloss.NewStyle().Padding(0, 1).Bold(true).Reverse(true)
+)
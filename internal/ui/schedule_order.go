@@ -0,0 +1,157 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+// scheduleSort orders the games shown on the schedule.
+type scheduleSort struct {
+	key   string
+	label string
+	less  func(a, b mlb.ScheduleGame, team string) bool
+}
+
+// scheduleFilter narrows the games shown on the schedule.
+type scheduleFilter struct {
+	key   string
+	label string
+	keep  func(game mlb.ScheduleGame) bool
+	// mlbOnly filters match MLB league or division IDs, so they would hide
+	// every game at other levels.
+	mlbOnly bool
+}
+
+// scheduleSorts and scheduleFilters are cycled with the o and f keys. Their keys
+// are persisted in the config, so they must stay stable.
+var (
+	scheduleSorts = []scheduleSort{
+		{key: "time", label: "Start time", less: byStartTime},
+		{key: "live", label: "Live first", less: byLiveFirst},
+		{key: "close", label: "Closest game", less: byClosestGame},
+		{key: "favorite", label: "Favorite first", less: byFavoriteFirst},
+	}
+
+	scheduleFilters = []scheduleFilter{
+		{key: "all", label: "All games", keep: func(mlb.ScheduleGame) bool { return true }},
+		{key: "live", label: "Live", keep: func(game mlb.ScheduleGame) bool { return game.Status.AbstractGameCode == "L" }},
+		{key: "final", label: "Final", keep: func(game mlb.ScheduleGame) bool { return game.Status.AbstractGameCode == "F" }},
+		leagueFilter("al", "AL", 103),
+		leagueFilter("nl", "NL", 104),
+		divisionFilter("al-east", "AL East", 201),
+		divisionFilter("al-central", "AL Central", 202),
+		divisionFilter("al-west", "AL West", 200),
+		divisionFilter("nl-east", "NL East", 204),
+		divisionFilter("nl-central", "NL Central", 205),
+		divisionFilter("nl-west", "NL West", 203),
+	}
+)
+
+func leagueFilter(key, label string, leagueID int) scheduleFilter {
+	return scheduleFilter{key: key, label: label, mlbOnly: true, keep: func(game mlb.ScheduleGame) bool {
+		return game.Teams.Away.Team.League.ID == leagueID || game.Teams.Home.Team.League.ID == leagueID
+	}}
+}
+
+func divisionFilter(key, label string, divisionID int) scheduleFilter {
+	return scheduleFilter{key: key, label: label, mlbOnly: true, keep: func(game mlb.ScheduleGame) bool {
+		return game.Teams.Away.Team.Division.ID == divisionID || game.Teams.Home.Team.Division.ID == divisionID
+	}}
+}
+
+// sortIndex and filterIndex resolve persisted keys, falling back to the first mode.
+func sortIndex(key string) int {
+	for idx, mode := range scheduleSorts {
+		if mode.key == key {
+			return idx
+		}
+	}
+	return 0
+}
+
+func filterIndex(key string) int {
+	for idx, mode := range scheduleFilters {
+		if mode.key == key {
+			return idx
+		}
+	}
+	return 0
+}
+
+// arrangeGames applies a filter and sort to a day's games without modifying the input.
+func arrangeGames(games []mlb.ScheduleGame, sortMode scheduleSort, filter scheduleFilter, team string) []mlb.ScheduleGame {
+	arranged := make([]mlb.ScheduleGame, 0, len(games))
+	for _, game := range games {
+		if filter.keep(game) {
+			arranged = append(arranged, game)
+		}
+	}
+	sort.SliceStable(arranged, func(i, j int) bool {
+		return sortMode.less(arranged[i], arranged[j], team)
+	})
+	return arranged
+}
+
+func byStartTime(a, b mlb.ScheduleGame, _ string) bool {
+	return a.GameDate.Before(b.GameDate)
+}
+
+// statusRank puts live games ahead of upcoming ones, then finished and postponed games.
+func statusRank(game mlb.ScheduleGame) int {
+	switch game.Status.AbstractGameCode {
+	case "L":
+		return 0
+	case "P":
+		return 1
+	case "F":
+		return 2
+	default:
+		return 3
+	}
+}
+
+func byLiveFirst(a, b mlb.ScheduleGame, team string) bool {
+	if ra, rb := statusRank(a), statusRank(b); ra != rb {
+		return ra < rb
+	}
+	return byStartTime(a, b, team)
+}
+
+// byClosestGame lists live games by run differential, tightest first, ahead of everything else.
+func byClosestGame(a, b mlb.ScheduleGame, team string) bool {
+	if ra, rb := statusRank(a), statusRank(b); ra != rb {
+		return ra < rb
+	}
+	if a.Status.AbstractGameCode == "L" {
+		if da, db := runDifferential(a), runDifferential(b); da != db {
+			return da < db
+		}
+	}
+	return byStartTime(a, b, team)
+}
+
+func runDifferential(game mlb.ScheduleGame) int {
+	if game.Linescore == nil {
+		return 0
+	}
+	diff := game.Linescore.Teams.Home.Runs - game.Linescore.Teams.Away.Runs
+	if diff < 0 {
+		return -diff
+	}
+	return diff
+}
+
+func byFavoriteFirst(a, b mlb.ScheduleGame, team string) bool {
+	if fa, fb := involvesTeam(a, team), involvesTeam(b, team); fa != fb {
+		return fa
+	}
+	return byStartTime(a, b, team)
+}
+
+func involvesTeam(game mlb.ScheduleGame, team string) bool {
+	return team != "" &&
+		(strings.EqualFold(game.Teams.Away.Team.Abbreviation, team) ||
+			strings.EqualFold(game.Teams.Home.Team.Abbreviation, team))
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func orderGame(pk int, code string, hour int, awayRuns, homeRuns int) mlb.ScheduleGame {
+	game := teamScheduleGame(pk*10, pk*10+1, awayRuns, homeRuns)
+	game.GamePk = pk
+	game.Status = mlb.GameStatus{AbstractGameCode: code}
+	game.GameDate = time.Date(2024, time.June, 12, hour, 0, 0, 0, time.UTC)
+	return game
+}
+
+func gamePks(games []mlb.ScheduleGame) []int {
+	pks := make([]int, len(games))
+	for idx, game := range games {
+		pks[idx] = game.GamePk
+	}
+	return pks
+}
+
+func TestArrangeGamesSorts(t *testing.T) {
+	games := []mlb.ScheduleGame{
+		orderGame(1, "F", 17, 2, 9),
+		orderGame(2, "L", 20, 4, 1),
+		orderGame(3, "P", 23, 0, 0),
+		orderGame(4, "L", 19, 3, 3),
+	}
+	games[2].Teams.Home.Team.Abbreviation = "SEA"
+
+	tests := map[string][]int{
+		"time":     {1, 4, 2, 3},
+		"live":     {4, 2, 3, 1},
+		"close":    {4, 2, 3, 1},
+		"favorite": {3, 1, 4, 2},
+	}
+	for key, want := range tests {
+		got := gamePks(arrangeGames(games, scheduleSorts[sortIndex(key)], scheduleFilters[0], "sea"))
+		for idx := range want {
+			if got[idx] != want[idx] {
+				t.Fatalf("%s sort: expected %v, got %v", key, want, got)
+			}
+		}
+	}
+}
+
+func TestArrangeGamesFilters(t *testing.T) {
+	games := []mlb.ScheduleGame{orderGame(1, "F", 17, 2, 9), orderGame(2, "L", 20, 4, 1)}
+	games[0].Teams.Home.Team.Division = mlb.TeamRef{ID: 200, Name: "American League West"}
+
+	if got := gamePks(arrangeGames(games, scheduleSorts[0], scheduleFilters[filterIndex("live")], "")); len(got) != 1 || got[0] != 2 {
+		t.Fatalf("expected only the live game, got %v", got)
+	}
+	if got := gamePks(arrangeGames(games, scheduleSorts[0], scheduleFilters[filterIndex("al-west")], "")); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("expected only the AL West game, got %v", got)
+	}
+	if filterIndex("bogus") != 0 || sortIndex("") != 0 {
+		t.Fatalf("expected unknown keys to fall back to the defaults")
+	}
+}
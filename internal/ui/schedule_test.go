@@ -1,14 +1,45 @@
 package ui
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea/v2"
+
+	"go.dalton.dog/batterup/internal/config"
 	"go.dalton.dog/batterup/internal/mlb"
 	"go.dalton.dog/batterup/internal/styles"
 )
 
+type stubTransport func(*http.Request) (*http.Response, error)
+
+func (f stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// stubScheduleHub serves every schedule request with one game whose gamePk is
+// the requested day as YYYYMMDD, so tests can tell which day a load was for.
+func stubScheduleHub(t *testing.T) *mlb.Hub {
+	t.Helper()
+	transport := stubTransport(func(req *http.Request) (*http.Response, error) {
+		day, err := time.Parse("01/02/2006", req.URL.Query().Get("date"))
+		if err != nil {
+			return nil, err
+		}
+		body := fmt.Sprintf(`{"dates": [{"date": %q, "games": [{"gamePk": %s}]}]}`, day.Format("2006-01-02"), day.Format("20060102"))
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return mlb.NewHub(ctx, mlb.NewClientWithHTTP(&http.Client{Transport: transport}), mlb.HubPolicy{})
+}
+
 func TestSameDay(t *testing.T) {
 	a := time.Date(2024, time.April, 1, 10, 0, 0, 0, time.UTC)
 	b := time.Date(2024, time.April, 1, 23, 59, 0, 0, time.UTC)
@@ -27,22 +58,23 @@ func TestDescribeGameStatusPreGameVariants(t *testing.T) {
 		GameDate: time.Date(2024, time.July, 4, 17, 5, 0, 0, time.FixedZone("EDT", -4*3600)),
 	}
 
+	// The doubleheader is noted separately, so game 2 still shows when it starts.
 	game := base
-	game.DoubleHeader = "Y"
+	game.DoubleHeader = mlb.DoubleHeaderTraditional
 	game.GameNumber = 2
-	if got := describeGameStatus(game); got != "Game 2" {
-		t.Fatalf("expected double header label, got %q", got)
+	want := game.GameDate.Local().Format("Starts @ 3:04 PM MST")
+	if got := describeGameStatus(game, displayClock{}); got != want {
+		t.Fatalf("expected game 2's start time %q, got %q", want, got)
 	}
 
 	game = base
 	game.Status.StartTimeTBD = true
-	if got := describeGameStatus(game); got != "Start time TBD" {
+	if got := describeGameStatus(game, displayClock{}); got != "Start time TBD" {
 		t.Fatalf("expected TBD label, got %q", got)
 	}
 
 	game = base
-	want := game.GameDate.Local().Format("Starts @ 3:04 PM MST")
-	if got := describeGameStatus(game); got != want {
+	if got := describeGameStatus(game, displayClock{}); got != want {
 		t.Fatalf("expected formatted start time %q, got %q", want, got)
 	}
 }
@@ -52,13 +84,13 @@ func TestDescribeGameStatusLive(t *testing.T) {
 		Status:    mlb.GameStatus{AbstractGameCode: "L", DetailedState: "In Progress"},
 		Linescore: &mlb.GameLineScore{InningState: "Top", CurrentInningOrdinal: "4th"},
 	}
-	if got := describeGameStatus(game); got != "Top 4th" {
+	if got := describeGameStatus(game, displayClock{}); got != "Top 4th" {
 		t.Fatalf("expected inning status, got %q", got)
 	}
 
 	game.Linescore.InningState = ""
 	game.Linescore.CurrentInningOrdinal = ""
-	if got := describeGameStatus(game); got != "In Progress" {
+	if got := describeGameStatus(game, displayClock{}); got != "In Progress" {
 		t.Fatalf("expected detailed state fallback, got %q", got)
 	}
 }
@@ -71,12 +103,12 @@ func TestDescribeGameStatusFinal(t *testing.T) {
 			Reason:           "Rain",
 		},
 	}
-	if got := describeGameStatus(game); got != "Final | Rain" {
+	if got := describeGameStatus(game, displayClock{}); got != "Final | Rain" {
 		t.Fatalf("expected reason appended, got %q", got)
 	}
 
 	game.Status.Reason = ""
-	if got := describeGameStatus(game); got != "Final" {
+	if got := describeGameStatus(game, displayClock{}); got != "Final" {
 		t.Fatalf("expected detailed state when no reason, got %q", got)
 	}
 }
@@ -116,3 +148,476 @@ func TestFormatScheduleTeamRecordOnlyWhenTooTight(t *testing.T) {
 		t.Fatalf("expected record fallback when width tight, got %q", got)
 	}
 }
+
+func TestScheduleWeekJumps(t *testing.T) {
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	s := NewScheduleModel(nil, stubScheduleHub(t), nil, displayClock{}, Options{Date: start})
+
+	model, cmd := s.Update(keyPress("]"))
+	s = model.(ScheduleModel)
+	if cmd == nil || !s.loading || !sameDay(s.date, start.AddDate(0, 0, 7)) {
+		t.Fatalf("expected a week forward with a load, got %v", s.date)
+	}
+
+	model, _ = s.Update(keyPress("["))
+	s = model.(ScheduleModel)
+	if !sameDay(s.date, start) {
+		t.Fatalf("expected a week back to the start date, got %v", s.date)
+	}
+}
+
+func TestScheduleCalendarJumpsToCursor(t *testing.T) {
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	s := NewScheduleModel(nil, stubScheduleHub(t), nil, displayClock{}, Options{Date: start})
+
+	model, cmd := s.Update(keyPress("c"))
+	s = model.(ScheduleModel)
+	if !s.calendarOpen || cmd == nil {
+		t.Fatalf("expected calendar to open and load month counts")
+	}
+
+	model, _ = s.Update(keyPress("l"))
+	s = model.(ScheduleModel)
+	if !sameDay(s.date, start) {
+		t.Fatalf("expected schedule date unchanged while browsing the calendar")
+	}
+
+	model, cmd = s.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	s = model.(ScheduleModel)
+	if s.calendarOpen || cmd == nil || !s.loading || !sameDay(s.date, start.AddDate(0, 0, 1)) {
+		t.Fatalf("expected enter to close the calendar and load the chosen date, got %v", s.date)
+	}
+}
+
+func TestScheduleTabChangesSport(t *testing.T) {
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	s := NewScheduleModel(nil, stubScheduleHub(t), nil, displayClock{}, Options{Date: start})
+
+	model, cmd := s.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	s = model.(ScheduleModel)
+	if cmd == nil || !s.loading || s.sportID() != mlb.Sports[1].ID {
+		t.Fatalf("expected tab to select %s and reload, got sport %d", mlb.Sports[1].Name, s.sportID())
+	}
+
+	model, _ = s.Update(scheduleLoadedMsg{date: start, sportID: mlb.SportMLB, games: make([]mlb.ScheduleGame, 2)})
+	s = model.(ScheduleModel)
+	if len(s.games) != 0 || !s.loading {
+		t.Fatalf("expected games for the previous level to be ignored")
+	}
+
+	model, _ = s.Update(tea.KeyPressMsg{Code: tea.KeyTab, Mod: tea.ModShift})
+	s = model.(ScheduleModel)
+	if s.sportID() != mlb.SportMLB {
+		t.Fatalf("expected shift+tab to return to MLB, got %d", s.sportID())
+	}
+}
+
+func TestScheduleGameTypeNarrowsTheFetch(t *testing.T) {
+	var requested atomic.Value
+	transport := stubTransport(func(req *http.Request) (*http.Response, error) {
+		requested.Store(req.URL.Query().Get("gameType"))
+		body := `{"dates": [{"date": "2024-06-12", "games": [{"gamePk": 1}]}]}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	hub := mlb.NewHub(ctx, mlb.NewClientWithHTTP(&http.Client{Transport: transport}), mlb.HubPolicy{})
+
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	s := NewScheduleModel(nil, hub, nil, displayClock{}, Options{Date: start})
+	model, cmd := s.Update(keyPress("y"))
+	s = model.(ScheduleModel)
+	if cmd == nil || !s.loading {
+		t.Fatalf("expected a game type change to reload the day")
+	}
+	t.Cleanup(s.unsubscribe)
+
+	loaded, ok := cmd().(scheduleLoadedMsg)
+	if !ok || loaded.gameType != s.gameType {
+		t.Fatalf("expected the day to load for the new game type, got %#v", loaded)
+	}
+	if got := requested.Load(); got != mlb.GameTypeRegularSeason {
+		t.Fatalf("expected only regular season games to be requested, got %v", got)
+	}
+
+	model, _ = s.Update(scheduleLoadedMsg{date: start, sportID: mlb.SportMLB, games: make([]mlb.ScheduleGame, 2)})
+	s = model.(ScheduleModel)
+	if len(s.games) != 0 || !s.loading {
+		t.Fatalf("expected games for the previous game type to be ignored")
+	}
+}
+
+func TestScheduleNotes(t *testing.T) {
+	game := mlb.ScheduleGame{DoubleHeader: mlb.DoubleHeaderSplit, GameNumber: 2}
+	if got := scheduleNotes(game, displayClock{}); got != "Split DH G2" {
+		t.Fatalf("expected split doubleheader note, got %q", got)
+	}
+
+	suspended := mlb.ScheduleGame{
+		DoubleHeader: mlb.DoubleHeaderNone,
+		ResumeDate:   time.Date(2024, time.June, 12, 18, 0, 0, 0, time.Local),
+	}
+	if got := scheduleNotes(suspended, displayClock{}); got != "Resumes 6/12" {
+		t.Fatalf("expected resume note, got %q", got)
+	}
+
+	resumed := mlb.ScheduleGame{ResumedFrom: time.Date(2024, time.June, 10, 18, 0, 0, 0, time.Local)}
+	if got := scheduleNotes(resumed, displayClock{}); got != "Resumed from 6/10" {
+		t.Fatalf("expected resumed-from note, got %q", got)
+	}
+}
+
+func TestGroupDoubleHeadersPairsGames(t *testing.T) {
+	dh := func(pk, away, home, number int) mlb.ScheduleGame {
+		game := teamScheduleGame(away, home, 0, 0)
+		game.GamePk = pk
+		game.DoubleHeader = mlb.DoubleHeaderSplit
+		game.GameNumber = number
+		return game
+	}
+	single := teamScheduleGame(5, 6, 0, 0)
+	single.GamePk = 2
+	single.DoubleHeader = mlb.DoubleHeaderNone
+
+	games, groups := groupDoubleHeaders([]mlb.ScheduleGame{dh(1, 1, 2, 1), single, dh(3, 1, 2, 2)})
+
+	var order []int
+	for _, game := range games {
+		order = append(order, game.GamePk)
+	}
+	if len(order) != 3 || order[0] != 1 || order[1] != 3 || order[2] != 2 {
+		t.Fatalf("expected doubleheader games to be adjacent, got %v", order)
+	}
+	if groups[0] == 0 || groups[0] != groups[1] || groups[2] != 0 {
+		t.Fatalf("unexpected groups %v", groups)
+	}
+}
+
+func TestScheduleKeepsSelectedGameAcrossReloads(t *testing.T) {
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	s := NewScheduleModel(nil, nil, nil, displayClock{}, Options{Date: start})
+
+	games := []mlb.ScheduleGame{orderGame(1, "P", 17, 0, 0), orderGame(2, "P", 18, 0, 0), orderGame(3, "P", 19, 0, 0)}
+	model, _ := s.Update(scheduleLoadedMsg{date: start, sportID: mlb.SportMLB, games: games})
+	s = model.(ScheduleModel)
+	model, _ = s.Update(keyPress("l"))
+	s = model.(ScheduleModel)
+	if s.selectedPk != 2 {
+		t.Fatalf("expected game 2 to be selected, got %d", s.selectedPk)
+	}
+
+	// A delay pushes game 2's start time past game 3's.
+	delayed := orderGame(2, "P", 20, 0, 0)
+	model, _ = s.Update(scheduleLoadedMsg{date: start, sportID: mlb.SportMLB, games: []mlb.ScheduleGame{games[0], delayed, games[2]}})
+	s = model.(ScheduleModel)
+	if s.grid.GetIndex() != 2 || s.games[s.grid.GetIndex()].GamePk != 2 {
+		t.Fatalf("expected the cursor to follow game 2, got index %d", s.grid.GetIndex())
+	}
+}
+
+func TestScheduleSortChangeIsSaved(t *testing.T) {
+	var saved config.Schedule
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	s := NewScheduleModel(nil, nil, nil, displayClock{}, Options{
+		Date:     start,
+		Schedule: config.Schedule{Filter: "live"},
+		SaveSchedule: func(prefs config.Schedule) error {
+			saved = prefs
+			return nil
+		},
+	})
+	if scheduleFilters[s.filter].key != "live" {
+		t.Fatalf("expected the saved filter to be restored")
+	}
+
+	model, cmd := s.Update(keyPress("o"))
+	s = model.(ScheduleModel)
+	if cmd == nil {
+		t.Fatalf("expected a save to be scheduled")
+	}
+	first := s.saveSeq
+	model, _ = s.Update(keyPress("o"))
+	s = model.(ScheduleModel)
+
+	if _, cmd := s.Update(schedulePrefsSaveMsg{seq: first}); cmd != nil {
+		t.Fatalf("expected a superseded change not to be saved")
+	}
+	_, cmd = s.Update(schedulePrefsSaveMsg{seq: s.saveSeq})
+	if cmd == nil {
+		t.Fatalf("expected the latest change to be saved")
+	}
+	cmd()
+	if saved.Sort != scheduleSorts[2%len(scheduleSorts)].key || saved.Filter != "live" {
+		t.Fatalf("expected sort and filter to be saved, got %+v", saved)
+	}
+}
+
+func TestSchedulePrefsSaveWhileAnotherViewIsOpen(t *testing.T) {
+	var saved config.Schedule
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	schedule := NewScheduleModel(nil, nil, nil, displayClock{}, Options{
+		Date: start,
+		SaveSchedule: func(prefs config.Schedule) error {
+			saved = prefs
+			return nil
+		},
+	})
+	m := Model{curModel: viewSchedule, schedule: schedule}
+
+	model, _ := m.Update(keyPress("o"))
+	model, _ = model.Update(openTeamScheduleMsg{Team: mlb.TeamInfo{ID: 1}, Date: start})
+	m = model.(Model)
+	if m.curModel != viewTeamSchedule {
+		t.Fatalf("expected the team schedule to open")
+	}
+
+	model, cmd := m.Update(schedulePrefsSaveMsg{seq: m.schedule.saveSeq})
+	if cmd == nil {
+		t.Fatalf("expected the settled sort to be saved from another view")
+	}
+	cmd()
+	if saved.Sort != scheduleSorts[1].key {
+		t.Fatalf("expected the sort to be saved, got %+v", saved)
+	}
+	m = model.(Model)
+	if m.schedule.savePrefs() != nil {
+		t.Fatalf("expected nothing left to save before quitting")
+	}
+}
+
+func TestSchedulePrefsSaveBeforeQuitting(t *testing.T) {
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	s := NewScheduleModel(nil, nil, nil, displayClock{}, Options{
+		Date:         start,
+		SaveSchedule: func(config.Schedule) error { return nil },
+	})
+	if s.savePrefs() != nil {
+		t.Fatalf("expected nothing to save before a change")
+	}
+	model, _ := s.Update(keyPress("f"))
+	s = model.(ScheduleModel)
+	if s.savePrefs() == nil {
+		t.Fatalf("expected a quit inside the delay to save the pending filter")
+	}
+	if s.savePrefs() != nil {
+		t.Fatalf("expected the pending filter to be saved once")
+	}
+}
+
+func TestScheduleLeagueFiltersOnlyApplyToMLB(t *testing.T) {
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	s := NewScheduleModel(nil, nil, nil, displayClock{}, Options{Date: start, Schedule: config.Schedule{Filter: "al-east"}})
+	model, _ := s.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	s = model.(ScheduleModel)
+
+	games := []mlb.ScheduleGame{orderGame(1, "L", 17, 1, 0), orderGame(2, "F", 13, 3, 2)}
+	model, _ = s.Update(scheduleLoadedMsg{date: start, sportID: s.sportID(), games: games})
+	s = model.(ScheduleModel)
+	if len(s.games) != len(games) || s.activeFilter().key != "all" {
+		t.Fatalf("expected a division filter to show every minor league game, got %d games with %q", len(s.games), s.activeFilter().key)
+	}
+
+	for _, want := range []string{"live", "final", "all"} {
+		model, _ = s.Update(keyPress("f"))
+		s = model.(ScheduleModel)
+		if got := s.activeFilter().key; got != want {
+			t.Fatalf("expected f to cycle to %q, got %q", want, got)
+		}
+	}
+
+	s.filter = filterIndex("al-east")
+	model, _ = s.Update(tea.KeyPressMsg{Code: tea.KeyTab, Mod: tea.ModShift})
+	s = model.(ScheduleModel)
+	if s.activeFilter().key != "al-east" {
+		t.Fatalf("expected the division filter to apply again to MLB, got %q", s.activeFilter().key)
+	}
+}
+
+func TestScheduleFlashesChangedTiles(t *testing.T) {
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	s := NewScheduleModel(nil, nil, nil, displayClock{}, Options{Date: start})
+
+	games := []mlb.ScheduleGame{orderGame(1, "L", 17, 1, 0), orderGame(2, "L", 18, 0, 0)}
+	model, _ := s.Update(scheduleLoadedMsg{date: start, sportID: mlb.SportMLB, games: games})
+	s = model.(ScheduleModel)
+	if len(s.flashing) != 0 {
+		t.Fatalf("expected nothing to flash on the first load, got %v", s.flashing)
+	}
+
+	scored := orderGame(2, "L", 18, 0, 2)
+	model, _ = s.Update(scheduleLoadedMsg{date: start, sportID: mlb.SportMLB, games: []mlb.ScheduleGame{games[0], scored}})
+	s = model.(ScheduleModel)
+	if !s.flashing[2] || s.flashing[1] || !s.grid.flashing[1] {
+		t.Fatalf("expected only game 2 to flash, got %v", s.flashing)
+	}
+
+	model, _ = s.Update(scheduleFlashDoneMsg{seq: s.flashSeq - 1})
+	s = model.(ScheduleModel)
+	if !s.flashing[2] {
+		t.Fatalf("expected a stale flash timer to be ignored")
+	}
+	model, _ = s.Update(scheduleFlashDoneMsg{seq: s.flashSeq})
+	s = model.(ScheduleModel)
+	if len(s.flashing) != 0 || len(s.grid.flashing) != 0 {
+		t.Fatalf("expected the flash to clear")
+	}
+}
+
+func TestScheduleCompactLayout(t *testing.T) {
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	s := NewScheduleModel(nil, nil, nil, displayClock{}, Options{Date: start})
+
+	games := []mlb.ScheduleGame{orderGame(1, "P", 17, 0, 0), orderGame(2, "L", 18, 3, 1), orderGame(3, "F", 19, 2, 5)}
+	model, _ := s.Update(scheduleLoadedMsg{date: start, sportID: mlb.SportMLB, games: games})
+	s = model.(ScheduleModel)
+
+	s.SetSize(4*(s.grid.itemWidth+2), 60)
+	if s.compact() {
+		t.Fatalf("expected tiles when several fit across")
+	}
+	s.SetSize(s.grid.itemWidth+2, 60)
+	if !s.compact() {
+		t.Fatalf("expected the list when only one tile fits across")
+	}
+
+	model, _ = s.Update(keyPress("j"))
+	s = model.(ScheduleModel)
+	if s.selectedPk != 2 || s.grid.GetIndex() != 1 {
+		t.Fatalf("expected list navigation to select game 2 in both layouts, got pk %d grid %d", s.selectedPk, s.grid.GetIndex())
+	}
+	if view := s.View(); !strings.Contains(view, "Probables") {
+		t.Fatalf("expected the compact table in the view")
+	}
+
+	model, _ = s.Update(keyPress("v"))
+	s = model.(ScheduleModel)
+	if s.compact() {
+		t.Fatalf("expected v to switch back to tiles")
+	}
+	model, _ = s.Update(keyPress("v"))
+	s = model.(ScheduleModel)
+	if !s.compact() {
+		t.Fatalf("expected v to switch to the list")
+	}
+}
+
+func TestScheduleListRow(t *testing.T) {
+	game := orderGame(2, "L", 18, 3, 1)
+	game.Linescore.InningState = "Top"
+	game.Linescore.CurrentInningOrdinal = "5th"
+	game.Linescore.Outs = 2
+	row := scheduleListRow(game, displayClock{})
+	if !strings.Contains(row[0], "Live") || row[3] != "3-1" || row[4] != "Top 5th" || strings.Count(row[5], "●") != 2 {
+		t.Fatalf("unexpected live row %q", row)
+	}
+
+	preview := orderGame(1, "P", 17, 0, 0)
+	preview.Teams.Away.ProbablePitcher = &mlb.SchedulePitcher{PersonRef: mlb.PersonRef{FullName: "Gerrit Cole"}}
+	row = scheduleListRow(preview, displayClock{})
+	if row[3] != "" || row[6] != "G. Cole vs TBD" {
+		t.Fatalf("unexpected preview row %q", row)
+	}
+}
+
+func TestRenderLiveSituation(t *testing.T) {
+	linescore := mlb.GameLineScore{
+		Balls:   3,
+		Strikes: 2,
+		Outs:    1,
+		Offense: mlb.OffensiveState{
+			Second: &mlb.BaseRunner{ID: 1},
+			Batter: &mlb.PersonRef{FullName: "Julio Rodriguez"},
+		},
+		Defense: mlb.Defense{Pitcher: &mlb.PersonRef{FullName: "Gerrit Cole"}},
+	}
+
+	out := renderLiveSituation(linescore, 40)
+	for _, want := range []string{"3-2", "P: G. Cole", "AB: J. Rodriguez"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in situation, got %q", want, out)
+		}
+	}
+	if strings.Count(out, "◆") != 1 || strings.Count(out, "●") != 1 {
+		t.Fatalf("expected one runner and one out, got %q", out)
+	}
+
+	if narrow := renderLiveSituation(linescore, 12); strings.Contains(narrow, "Rodriguez") {
+		t.Fatalf("expected the matchup to be truncated to the tile, got %q", narrow)
+	}
+}
+
+func probablePitcher(name string, wins, losses int, era string) *mlb.SchedulePitcher {
+	return &mlb.SchedulePitcher{
+		PersonRef: mlb.PersonRef{FullName: name},
+		Stats: []mlb.PitchingStatGroup{{
+			Splits: []mlb.PitchingStatSplit{{Stat: mlb.SeasonPitching{Wins: wins, Losses: losses, ERA: era}}},
+		}},
+	}
+}
+
+func TestRenderProbables(t *testing.T) {
+	away := probablePitcher("Gerrit Cole", 12, 4, "3.01")
+	home := probablePitcher("Brayan Bello", 9, 8, "4.20")
+
+	if got := renderProbables(away, home, 80); !strings.Contains(got, "Cole (12-4, 3.01) vs Bello (9-8, 4.20)") {
+		t.Fatalf("expected both starters on one line, got %q", got)
+	}
+	if got := renderProbables(away, nil, 80); !strings.Contains(got, "Cole (12-4, 3.01) vs TBD") {
+		t.Fatalf("expected a missing starter to read TBD, got %q", got)
+	}
+	if got := renderProbables(away, home, 24); strings.Count(got, "\n") != 1 {
+		t.Fatalf("expected narrow tiles to stack the starters, got %q", got)
+	}
+	if got := renderProbables(nil, nil, 80); got != "" {
+		t.Fatalf("expected nothing without probables, got %q", got)
+	}
+}
+
+func TestFormatDecisions(t *testing.T) {
+	decisions := &mlb.Decisions{
+		Winner: &mlb.PersonRef{FullName: "George Kirby"},
+		Loser:  &mlb.PersonRef{FullName: "Gerrit Cole"},
+	}
+	if got := formatDecisions(decisions); got != "W: Kirby  L: Cole" {
+		t.Fatalf("unexpected decisions %q", got)
+	}
+	decisions.Save = &mlb.PersonRef{FullName: "Andrés Muñoz"}
+	if got := formatDecisions(decisions); got != "W: Kirby  L: Cole  S: Muñoz" {
+		t.Fatalf("unexpected decisions with save %q", got)
+	}
+	if got := formatDecisions(nil); got != "" {
+		t.Fatalf("expected no decisions, got %q", got)
+	}
+}
+
+func TestScheduleDateChangeMovesSubscription(t *testing.T) {
+	start := time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)
+	s := NewScheduleModel(nil, stubScheduleHub(t), nil, displayClock{}, Options{Date: start})
+
+	model, cmd := s.Update(s.Init()())
+	s = model.(ScheduleModel)
+	first := s.subscription
+	if first == nil || cmd == nil {
+		t.Fatalf("expected Init to subscribe to the initial day")
+	}
+	model, _ = s.Update(cmd())
+	s = model.(ScheduleModel)
+	if len(s.allGames) != 1 || s.allGames[0].GamePk != 20240612 {
+		t.Fatalf("expected the initial day's games, got %+v", s.allGames)
+	}
+
+	model, cmd = s.Update(keyPress("]"))
+	s = model.(ScheduleModel)
+	if _, open := <-first.Updates(); open {
+		t.Fatalf("expected the previous day's subscription to be closed")
+	}
+	msg, ok := cmd().(scheduleLoadedMsg)
+	if !ok || !sameDay(msg.date, start.AddDate(0, 0, 7)) || msg.subscription != s.subscription {
+		t.Fatalf("expected a load for the new day from the new subscription, got %+v", msg)
+	}
+	model, _ = s.Update(msg)
+	s = model.(ScheduleModel)
+	if s.loading || len(s.allGames) != 1 || s.allGames[0].GamePk != 20240619 {
+		t.Fatalf("expected the new day's games, got %+v", s.allGames)
+	}
+}
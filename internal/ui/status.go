@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+)
+
+// feedStatus tracks how fresh a polled view's data is and whether the polls
+// behind it are failing, for the status line in the app footer.
+type feedStatus struct {
+	// updated is when the data on screen was produced. Zero means nothing
+	// has loaded yet.
+	updated time.Time
+	// nextPoll is when the next fetch or retry is due. Zero once polling has stopped.
+	nextPoll time.Time
+	// failures counts consecutive failed fetches.
+	failures int
+}
+
+// statusTickMsg redraws the status line so its countdown keeps moving.
+type statusTickMsg struct{}
+
+func statusTick() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg { return statusTickMsg{} })
+}
+
+// succeeded records a good fetch of data produced at updated.
+func (f *feedStatus) succeeded(updated, nextPoll time.Time) {
+	f.updated = updated
+	f.nextPoll = nextPoll
+	f.failures = 0
+}
+
+// failed records a fetch that failed, keeping the time of the last good data.
+func (f *feedStatus) failed(nextPoll time.Time) {
+	f.nextPoll = nextPoll
+	f.failures++
+}
+
+// offline reports whether the latest fetch failed.
+func (f feedStatus) offline() bool {
+	return f.failures > 0
+}
+
+// render describes the status as of now, in now's timezone, such as "Updated 7:15:44 PM • next
+// update in 8s". It is empty until the first fetch finishes.
+func (f feedStatus) render(now time.Time) string {
+	if f.updated.IsZero() && !f.offline() {
+		return ""
+	}
+
+	var parts []string
+	if f.offline() {
+		parts = append(parts, statusOfflineStyle.Render("OFFLINE"))
+	}
+	if f.updated.IsZero() {
+		parts = append(parts, "Not updated yet")
+	} else {
+		parts = append(parts, "Updated "+f.updated.In(now.Location()).Format("3:04:05 PM"))
+	}
+
+	switch {
+	case f.nextPoll.IsZero():
+	case !f.nextPoll.After(now):
+		parts = append(parts, "updating…")
+	case f.offline():
+		failures := "1 failure"
+		if f.failures > 1 {
+			failures = fmt.Sprintf("%d failures", f.failures)
+		}
+		parts = append(parts, fmt.Sprintf("retrying in %s (%s)", formatCountdown(f.nextPoll.Sub(now)), failures))
+	default:
+		parts = append(parts, "next update in "+formatCountdown(f.nextPoll.Sub(now)))
+	}
+	return strings.Join(parts, " • ")
+}
+
+// formatCountdown rounds a wait up to whole seconds, as "8s" or "4m05s".
+func formatCountdown(d time.Duration) string {
+	seconds := int((d + time.Second - 1) / time.Second)
+	if seconds < 60 {
+		return fmt.Sprintf("%ds", seconds)
+	}
+	return fmt.Sprintf("%dm%02ds", seconds/60, seconds%60)
+}
+
+var statusOfflineStyle = lipgloss.NewStyle().Background(lipgloss.Red).Foreground(lipgloss.White).Bold(true).Padding(0, 1)
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func TestFeedStatusRender(t *testing.T) {
+	now := time.Date(2024, time.June, 12, 19, 15, 50, 0, time.UTC)
+
+	var status feedStatus
+	if got := status.render(now); got != "" {
+		t.Fatalf("expected no status before the first fetch, got %q", got)
+	}
+
+	status.succeeded(now.Add(-6*time.Second), now.Add(7500*time.Millisecond))
+	if got := status.render(now); got != "Updated 7:15:44 PM • next update in 8s" {
+		t.Fatalf("unexpected live status %q", got)
+	}
+
+	status.failed(now.Add(75 * time.Second))
+	status.failed(now.Add(75 * time.Second))
+	got := status.render(now)
+	for _, want := range []string{"OFFLINE", "Updated 7:15:44 PM", "retrying in 1m15s (2 failures)"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected offline status to contain %q, got %q", want, got)
+		}
+	}
+
+	status.succeeded(now, time.Time{})
+	if got := status.render(now); got != "Updated 7:15:50 PM" {
+		t.Fatalf("expected a recovered, finished feed to drop the badge and countdown, got %q", got)
+	}
+}
+
+func TestGameKeepsFeedWhenPollFails(t *testing.T) {
+	gm := GameModel{gameID: 7, requestID: 1, active: true}
+	feed := &mlb.GameFeed{MetaData: mlb.MetaData{TimeStamp: "20240612_191544"}}
+	updated, ok := feed.MetaData.Time()
+	if !ok || !updated.Equal(time.Date(2024, time.June, 12, 19, 15, 44, 0, time.UTC)) {
+		t.Fatalf("expected the feed timestamp to parse as UTC, got %v", updated)
+	}
+
+	gm, _ = gm.Update(gameLoadedMsg{id: 1, gameID: 7, feed: feed, updated: updated})
+	gm, _ = gm.Update(gameFailedMsg{id: 1, gameID: 7, err: errors.New("no route to host")})
+	if gm.feed != feed || !gm.status.offline() || !gm.status.updated.Equal(updated) {
+		t.Fatalf("expected the last good feed to stay loaded while offline")
+	}
+	if view := gm.View(); strings.Contains(view, "Error loading game") {
+		t.Fatalf("expected the feed rather than an error after a failed poll\n%s", view)
+	}
+}
+
+func TestStatusTickOnlyRunsWhilePolling(t *testing.T) {
+	m := Model{curModel: viewTeamSchedule}
+	if _, cmd := m.Update(statusTickMsg{}); cmd != nil {
+		t.Fatalf("expected no tick on a view without a status")
+	}
+
+	m = Model{curModel: viewGame}
+	m.game.status.succeeded(time.Now(), time.Time{})
+	if _, cmd := m.Update(statusTickMsg{}); cmd != nil {
+		t.Fatalf("expected no tick once polling has stopped")
+	}
+
+	m.game.status.succeeded(time.Now(), time.Now().Add(10*time.Second))
+	model, cmd := m.Update(statusTickMsg{})
+	if cmd == nil || !model.(Model).ticking {
+		t.Fatalf("expected the countdown to tick while a poll is scheduled")
+	}
+	if _, cmd := model.Update(tea.KeyPressMsg{Code: 'x', Text: "x"}); cmd != nil {
+		t.Fatalf("expected a single pending tick at a time")
+	}
+}
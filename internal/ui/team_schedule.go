@@ -0,0 +1,370 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea/v2"
+	"github.com/charmbracelet/lipgloss/v2"
+	"github.com/charmbracelet/lipgloss/v2/table"
+
+	"go.dalton.dog/batterup/internal/mlb"
+	"go.dalton.dog/batterup/internal/styles"
+)
+
+// TeamScheduleModel shows one club's games a week or a month at a time.
+type TeamScheduleModel struct {
+	client   *mlb.Client
+	context  context.Context
+	clock    displayClock
+	requests requestScope
+
+	team    mlb.TeamInfo
+	sportID int
+	mode    teamScheduleMode
+	anchor  time.Time
+
+	days    map[string][]mlb.ScheduleGame
+	loading bool
+	err     error
+
+	width  int
+	height int
+}
+
+type teamScheduleMode int
+
+const (
+	teamScheduleWeek teamScheduleMode = iota
+	teamScheduleMonth
+)
+
+type teamScheduleLoadedMsg struct {
+	teamID int
+	start  time.Time
+	days   map[string][]mlb.ScheduleGame
+}
+
+type teamScheduleFailedMsg struct {
+	teamID int
+	start  time.Time
+	err    error
+}
+
+// openTeamScheduleMsg instructs the root model to show a club's schedule around a date.
+type openTeamScheduleMsg struct {
+	Team    mlb.TeamInfo
+	SportID int
+	Date    time.Time
+}
+
+func NewTeamScheduleModel(client *mlb.Client, ctx context.Context, clock displayClock) TeamScheduleModel {
+	return TeamScheduleModel{
+		client:  client,
+		context: ctx,
+		clock:   clock,
+	}
+}
+
+func (m *TeamScheduleModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// SetActive cancels a load in flight when the view is left, and retries it
+// when the view is shown again.
+func (m *TeamScheduleModel) SetActive(active bool) tea.Cmd {
+	if active {
+		if requestCancelled(m.err) {
+			return m.reload()
+		}
+		return nil
+	}
+	m.requests.end()
+	if m.loading {
+		m.loading = false
+		m.err = context.Canceled
+	}
+	return nil
+}
+
+// span returns the first and last day covered by the current mode.
+func (m TeamScheduleModel) span() (time.Time, time.Time) {
+	day := truncateToDay(m.anchor)
+	if m.mode == teamScheduleMonth {
+		start := firstOfMonth(day)
+		return start, start.AddDate(0, 1, -1)
+	}
+	start := day.AddDate(0, 0, -int(day.Weekday()))
+	return start, start.AddDate(0, 0, 6)
+}
+
+func (m TeamScheduleModel) Update(msg tea.Msg) (TeamScheduleModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case openTeamScheduleMsg:
+		m.team = msg.Team
+		m.sportID = msg.SportID
+		m.anchor = truncateToDay(msg.Date)
+		return m, m.reload()
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "w", "W":
+			if m.mode != teamScheduleWeek {
+				m.mode = teamScheduleWeek
+				return m, m.reload()
+			}
+		case "m", "M":
+			if m.mode != teamScheduleMonth {
+				m.mode = teamScheduleMonth
+				return m, m.reload()
+			}
+		case "p", "P":
+			return m, m.shift(-1)
+		case "n", "N":
+			return m, m.shift(1)
+		case "t", "T":
+			m.anchor = m.clock.Today()
+			return m, m.reload()
+		}
+	case teamScheduleLoadedMsg:
+		if start, _ := m.span(); msg.teamID != m.team.ID || !sameDay(msg.start, start) {
+			return m, nil
+		}
+		m.loading = false
+		m.err = nil
+		m.days = msg.days
+	case teamScheduleFailedMsg:
+		if start, _ := m.span(); msg.teamID != m.team.ID || !sameDay(msg.start, start) {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+	}
+	return m, nil
+}
+
+// shift moves the view by whole weeks or months depending on the mode.
+func (m *TeamScheduleModel) shift(direction int) tea.Cmd {
+	if m.mode == teamScheduleMonth {
+		m.anchor = addMonthsClamped(m.anchor, direction)
+	} else {
+		m.anchor = m.anchor.AddDate(0, 0, 7*direction)
+	}
+	return m.reload()
+}
+
+func (m *TeamScheduleModel) reload() tea.Cmd {
+	m.days = nil
+	m.loading = true
+	m.err = nil
+	return m.load()
+}
+
+func (m *TeamScheduleModel) load() tea.Cmd {
+	client := m.client
+	ctx := m.requests.begin(m.context)
+	teamID := m.team.ID
+	sportID := m.sportID
+	start, end := m.span()
+	return func() tea.Msg {
+		resp, err := client.FetchSchedule(ctx, mlb.ScheduleQuery{StartDate: start, EndDate: end, TeamID: teamID, SportID: sportID, Hydrate: []string{"team", "linescore"}})
+		if err != nil {
+			return teamScheduleFailedMsg{teamID: teamID, start: start, err: err}
+		}
+		return teamScheduleLoadedMsg{teamID: teamID, start: start, days: gamesByDay(resp)}
+	}
+}
+
+func (m TeamScheduleModel) View() string {
+	start, end := m.span()
+
+	title := m.team.TeamName
+	if m.mode == teamScheduleMonth {
+		title += " • " + start.Format("January 2006")
+	} else {
+		title += fmt.Sprintf(" • %s – %s", start.Format("Jan 2"), end.Format("Jan 2, 2006"))
+	}
+
+	parts := []string{
+		lipgloss.NewStyle().Bold(true).PaddingTop(1).Render(title),
+	}
+
+	switch {
+	case requestCancelled(m.err):
+		parts = append(parts, renderCancelled("schedule"))
+	case m.err != nil:
+		parts = append(parts, lipgloss.NewStyle().Foreground(lipgloss.Red).Render("Error loading schedule: "+m.err.Error()))
+	case m.loading:
+		parts = append(parts, "Loading schedule…")
+	case m.mode == teamScheduleMonth:
+		parts = append(parts, m.renderMonth(start))
+	default:
+		parts = append(parts, m.renderWeek(start))
+	}
+
+	parts = append(parts, styles.HelpTextStyle.Render("[W]eek • [M]onth • << [P]rev | [T]oday | [N]ext >> • esc to return"))
+	return lipgloss.JoinVertical(lipgloss.Center, parts...)
+}
+
+func (m TeamScheduleModel) renderWeek(start time.Time) string {
+	tbl := table.New().
+		Border(lipgloss.RoundedBorder()).
+		Headers("Date", "Opponent", "Result", "Record").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return styles.ScheduleTableHeader.Padding(0, 1)
+			}
+			return lipgloss.NewStyle().Padding(0, 1)
+		})
+
+	for offset := range 7 {
+		day := start.AddDate(0, 0, offset)
+		label := day.Format("Mon Jan 2")
+		games := m.days[day.Format(calendarDayKey)]
+		if len(games) == 0 {
+			tbl = tbl.Row(label, teamOffDayStyle.Render("Off day"), "", "")
+			continue
+		}
+		for _, game := range games {
+			summary := summarizeTeamGame(game, m.team.ID, m.clock)
+			tbl = tbl.Row(label, summary.opponent, summary.style.Render(summary.outcome), summary.record)
+			label = ""
+		}
+	}
+	return tbl.String()
+}
+
+func (m TeamScheduleModel) renderMonth(month time.Time) string {
+	weekdays := make([]string, 0, 7)
+	for _, day := range []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"} {
+		weekdays = append(weekdays, teamMonthCellStyle.Render(styles.ScheduleTableHeader.Render(day)))
+	}
+	rows := []string{lipgloss.JoinHorizontal(lipgloss.Top, weekdays...)}
+
+	day := month.AddDate(0, 0, -int(month.Weekday()))
+	for week := 0; week < 6; week++ {
+		if week > 0 && day.Month() != month.Month() {
+			break
+		}
+		// A doubleheader takes more lines than one game, so the whole week
+		// grows to match and the cell borders stay in line.
+		height := teamMonthCellStyle.GetHeight()
+		for offset := range 7 {
+			lines := len(m.monthDayLines(day.AddDate(0, 0, offset), month))
+			height = max(height, lines+teamMonthCellStyle.GetVerticalFrameSize())
+		}
+		cells := make([]string, 0, 7)
+		for range 7 {
+			cells = append(cells, m.renderMonthDay(day, month, height))
+			day = day.AddDate(0, 0, 1)
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// monthDayLines lists a month cell's contents: the day of the month, then the
+// opponent and result of each game. Days outside the month are blank.
+func (m TeamScheduleModel) monthDayLines(day, month time.Time) []string {
+	if day.Month() != month.Month() {
+		return nil
+	}
+	lines := []string{fmt.Sprintf("%d", day.Day())}
+	for _, game := range m.days[day.Format(calendarDayKey)] {
+		summary := summarizeTeamGame(game, m.team.ID, m.clock)
+		lines = append(lines, summary.opponent, summary.style.Render(summary.outcome))
+	}
+	return lines
+}
+
+// renderMonthDay draws one day of the month grid, height lines tall including
+// its border.
+func (m TeamScheduleModel) renderMonthDay(day, month time.Time, height int) string {
+	style := teamMonthCellStyle.Height(height)
+	if day.Month() != month.Month() {
+		return style.Render("")
+	}
+
+	lines := m.monthDayLines(day, month)
+	games := m.days[day.Format(calendarDayKey)]
+	if len(games) == 0 {
+		style = style.Faint(true)
+	}
+	if sameDay(day, m.clock.Today()) {
+		style = style.BorderForeground(lipgloss.Cyan)
+	}
+	return style.Render(strings.Join(lines, "\n"))
+}
+
+// teamGameSummary is one game seen from a single club's side.
+type teamGameSummary struct {
+	opponent string
+	outcome  string
+	record   string
+	style    lipgloss.Style
+}
+
+// summarizeTeamGame describes a game from teamID's point of view: the opponent
+// prefixed with "vs" or "@", then the result, live state or start time.
+func summarizeTeamGame(game mlb.ScheduleGame, teamID int, clock displayClock) teamGameSummary {
+	us, them := game.Teams.Home, game.Teams.Away
+	prefix := "vs"
+	if game.Teams.Away.Team.ID == teamID {
+		us, them = game.Teams.Away, game.Teams.Home
+		prefix = "@"
+	}
+
+	summary := teamGameSummary{
+		opponent: fmt.Sprintf("%s %s", prefix, safeTeam(them.Team.Abbreviation)),
+		style:    scheduleStatusStyle(game),
+	}
+
+	switch game.Status.AbstractGameCode {
+	case "F":
+		if game.Linescore == nil || !(us.IsWinner || them.IsWinner) {
+			summary.outcome = game.Status.DetailedState
+			break
+		}
+		ourRuns, theirRuns := game.Linescore.Teams.Home.Runs, game.Linescore.Teams.Away.Runs
+		if prefix == "@" {
+			ourRuns, theirRuns = theirRuns, ourRuns
+		}
+		result := "L"
+		summary.style = teamLossStyle
+		if us.IsWinner {
+			result = "W"
+			summary.style = styles.ScheduleWinnerTeam
+		}
+		summary.outcome = fmt.Sprintf("%s %d-%d", result, ourRuns, theirRuns)
+		summary.record = fmt.Sprintf("%d-%d", us.LeagueRecord.Wins, us.LeagueRecord.Losses)
+	case "P":
+		switch {
+		case game.Status.DetailedState != "" && game.Status.DetailedState != "Scheduled" && game.Status.DetailedState != "Pre-Game":
+			summary.outcome = game.Status.DetailedState
+		case game.Status.StartTimeTBD:
+			summary.outcome = "TBD"
+		default:
+			summary.outcome = clock.In(game.GameDate).Format("3:04 PM")
+		}
+	default:
+		summary.outcome = describeGameStatus(game, clock)
+	}
+	return summary
+}
+
+// gamesByDay groups a schedule response's games under each official game date.
+func gamesByDay(resp *mlb.ScheduleResponse) map[string][]mlb.ScheduleGame {
+	days := make(map[string][]mlb.ScheduleGame, len(resp.Dates))
+	for _, date := range resp.Dates {
+		days[date.Date] = append(days[date.Date], date.Games...)
+	}
+	return days
+}
+
+var (
+	teamOffDayStyle    = lipgloss.NewStyle().Faint(true)
+	teamLossStyle      = lipgloss.NewStyle().Foreground(lipgloss.Red)
+	teamMonthCellStyle = lipgloss.NewStyle().Width(11).Height(4).Border(lipgloss.NormalBorder()).Align(lipgloss.Center)
+)
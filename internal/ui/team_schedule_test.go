@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func teamScheduleGame(awayID, homeID int, awayRuns, homeRuns int) mlb.ScheduleGame {
+	return mlb.ScheduleGame{
+		Status:    mlb.GameStatus{AbstractGameCode: "F", DetailedState: "Final"},
+		Linescore: &mlb.GameLineScore{Teams: mlb.LineScoreTotals{Away: mlb.LineScoreTeam{Runs: awayRuns}, Home: mlb.LineScoreTeam{Runs: homeRuns}}},
+		Teams: mlb.ScheduleTeams{
+			Away: mlb.ScheduleTeam{
+				Team:         mlb.TeamInfo{ID: awayID, Abbreviation: "AWY"},
+				LeagueRecord: mlb.LeagueRecord{Wins: 30, Losses: 20},
+				IsWinner:     awayRuns > homeRuns,
+			},
+			Home: mlb.ScheduleTeam{
+				Team:         mlb.TeamInfo{ID: homeID, Abbreviation: "HME"},
+				LeagueRecord: mlb.LeagueRecord{Wins: 25, Losses: 25},
+				IsWinner:     homeRuns > awayRuns,
+			},
+		},
+	}
+}
+
+func TestSummarizeTeamGameFinal(t *testing.T) {
+	game := teamScheduleGame(1, 2, 5, 3)
+
+	away := summarizeTeamGame(game, 1, displayClock{})
+	if away.opponent != "@ HME" || away.outcome != "W 5-3" || away.record != "30-20" {
+		t.Fatalf("unexpected away summary %+v", away)
+	}
+
+	home := summarizeTeamGame(game, 2, displayClock{})
+	if home.opponent != "vs AWY" || home.outcome != "L 3-5" || home.record != "25-25" {
+		t.Fatalf("unexpected home summary %+v", home)
+	}
+}
+
+func TestSummarizeTeamGameUpcoming(t *testing.T) {
+	game := teamScheduleGame(1, 2, 0, 0)
+	game.Status = mlb.GameStatus{AbstractGameCode: "P", DetailedState: "Postponed"}
+	if got := summarizeTeamGame(game, 2, displayClock{}).outcome; got != "Postponed" {
+		t.Fatalf("expected postponed status, got %q", got)
+	}
+
+	game.Status = mlb.GameStatus{AbstractGameCode: "P", DetailedState: "Scheduled", StartTimeTBD: true}
+	if got := summarizeTeamGame(game, 2, displayClock{}); got.outcome != "TBD" || got.record != "" {
+		t.Fatalf("expected TBD without a record, got %+v", got)
+	}
+}
+
+func TestTeamScheduleSpan(t *testing.T) {
+	m := TeamScheduleModel{anchor: time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)}
+
+	start, end := m.span()
+	if !sameDay(start, time.Date(2024, time.June, 9, 0, 0, 0, 0, time.UTC)) || !sameDay(end, time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected week span %v – %v", start, end)
+	}
+
+	m.mode = teamScheduleMonth
+	start, end = m.span()
+	if !sameDay(start, time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)) || !sameDay(end, time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected month span %v – %v", start, end)
+	}
+}
+
+func TestTeamScheduleIgnoresStaleResults(t *testing.T) {
+	m := TeamScheduleModel{
+		team:    mlb.TeamInfo{ID: 2, TeamName: "Home"},
+		anchor:  time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC),
+		loading: true,
+	}
+	start, _ := m.span()
+
+	m, _ = m.Update(teamScheduleFailedMsg{teamID: 1, start: start, err: errors.New("boom")})
+	if m.err != nil || !m.loading {
+		t.Fatalf("expected a result for another team to be ignored")
+	}
+
+	days := map[string][]mlb.ScheduleGame{"2024-06-12": {teamScheduleGame(1, 2, 2, 4)}}
+	m, _ = m.Update(teamScheduleLoadedMsg{teamID: 2, start: start, days: days})
+	if m.loading {
+		t.Fatalf("expected the matching result to finish loading")
+	}
+
+	view := m.View()
+	for _, want := range []string{"vs AWY", "W 4-2", "Off day"} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("expected week view to contain %q\n%s", want, view)
+		}
+	}
+}
+
+func TestTeamScheduleCancelsLoadWhenLeft(t *testing.T) {
+	m := TeamScheduleModel{team: mlb.TeamInfo{ID: 2, TeamName: "Home"}, anchor: time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC)}
+	m.reload()
+	ctx := m.requests.ctx
+
+	m.SetActive(false)
+	if ctx.Err() == nil {
+		t.Fatalf("expected leaving the view to cancel the request in flight")
+	}
+	if m.loading || !requestCancelled(m.err) {
+		t.Fatalf("expected the load to be marked cancelled, got loading %v err %v", m.loading, m.err)
+	}
+	if view := m.View(); !strings.Contains(view, "Loading schedule cancelled") || strings.Contains(view, "Error") {
+		t.Fatalf("expected the cancellation to be shown apart from errors\n%s", view)
+	}
+
+	if cmd := m.SetActive(true); cmd == nil || !m.loading || m.err != nil {
+		t.Fatalf("expected returning to the view to retry the load")
+	}
+}
+
+func TestTeamMonthDoubleheaderKeepsWeekAligned(t *testing.T) {
+	m := TeamScheduleModel{
+		team: mlb.TeamInfo{ID: 2, TeamName: "Home"},
+		days: map[string][]mlb.ScheduleGame{
+			"2024-06-11": {teamScheduleGame(1, 2, 2, 4)},
+			"2024-06-12": {teamScheduleGame(1, 2, 2, 4), teamScheduleGame(1, 2, 5, 3)},
+		},
+	}
+
+	// Every cell of a week closes its border on the same line.
+	month := m.renderMonth(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+	for idx, line := range strings.Split(month, "\n") {
+		if corners := strings.Count(line, "└"); corners != 0 && corners != 7 {
+			t.Fatalf("line %d closes %d of 7 cells\n%s", idx, corners, month)
+		}
+	}
+	if !strings.Contains(month, "L 3-5") {
+		t.Fatalf("expected the doubleheader's second game to be shown\n%s", month)
+	}
+}
@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"fmt"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+// LineScoreMismatch describes a line score field where the play-by-play
+// reconstruction disagrees with the official feed.
+type LineScoreMismatch struct {
+	Field    string
+	Derived  string
+	Official string
+}
+
+func (m LineScoreMismatch) String() string {
+	return fmt.Sprintf("%s: derived %s, official %s", m.Field, m.Derived, m.Official)
+}
+
+// VerifyLineScore rebuilds the line score from the feed's plays and compares
+// the final snapshot against the feed's own linescore.
+func VerifyLineScore(feed *mlb.GameFeed) []LineScoreMismatch {
+	if feed == nil {
+		return nil
+	}
+	snapshots := buildPlaySnapshots(feed.LiveData.Plays.AllPlays)
+	var derived mlb.LiveLineScore
+	if len(snapshots) > 0 {
+		derived = snapshots[len(snapshots)-1].linescore
+	}
+	teams := feed.GameData.Teams
+	return compareLineScores(derived, feed.LiveData.Linescore, safeTeam(teams.Away.Abbreviation), safeTeam(teams.Home.Abbreviation))
+}
+
+func compareLineScores(derived, official mlb.LiveLineScore, awayLabel, homeLabel string) []LineScoreMismatch {
+	var mismatches []LineScoreMismatch
+	compareInt := func(field string, got, want int) {
+		if got != want {
+			mismatches = append(mismatches, LineScoreMismatch{
+				Field:    field,
+				Derived:  fmt.Sprintf("%d", got),
+				Official: fmt.Sprintf("%d", want),
+			})
+		}
+	}
+
+	compareInt(awayLabel+" R", derived.Teams.Away.Runs, official.Teams.Away.Runs)
+	compareInt(awayLabel+" H", derived.Teams.Away.Hits, official.Teams.Away.Hits)
+	compareInt(awayLabel+" E", derived.Teams.Away.Errors, official.Teams.Away.Errors)
+	compareInt(homeLabel+" R", derived.Teams.Home.Runs, official.Teams.Home.Runs)
+	compareInt(homeLabel+" H", derived.Teams.Home.Hits, official.Teams.Home.Hits)
+	compareInt(homeLabel+" E", derived.Teams.Home.Errors, official.Teams.Home.Errors)
+
+	innings := max(len(derived.Innings), len(official.Innings))
+	for i := range innings {
+		var got, want mlb.InningLine
+		if i < len(derived.Innings) {
+			got = derived.Innings[i]
+		}
+		if i < len(official.Innings) {
+			want = official.Innings[i]
+		}
+		label := ordinal(i + 1)
+		if diff, ok := compareInningRuns(got.Away.Runs, want.Away.Runs); ok {
+			mismatches = append(mismatches, LineScoreMismatch{Field: fmt.Sprintf("%s %s", awayLabel, label), Derived: diff[0], Official: diff[1]})
+		}
+		if diff, ok := compareInningRuns(got.Home.Runs, want.Home.Runs); ok {
+			mismatches = append(mismatches, LineScoreMismatch{Field: fmt.Sprintf("%s %s", homeLabel, label), Derived: diff[0], Official: diff[1]})
+		}
+	}
+	return mismatches
+}
+
+// compareInningRuns reports whether two inning cells differ, returning their display values.
+func compareInningRuns(got, want *int) ([2]string, bool) {
+	format := func(runs *int) string {
+		if runs == nil {
+			return "-"
+		}
+		return fmt.Sprintf("%d", *runs)
+	}
+	g, w := format(got), format(want)
+	return [2]string{g, w}, g != w
+}
@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"go.dalton.dog/batterup/internal/mlb"
+)
+
+func TestVerifyLineScoreMatches(t *testing.T) {
+	one := 1
+	feed := &mlb.GameFeed{
+		LiveData: mlb.LiveData{
+			Plays: mlb.Plays{AllPlays: []mlb.Play{
+				{
+					Result: mlb.PlayResult{EventType: "home_run", AwayScore: 1},
+					About:  mlb.PlayAbout{Inning: 1, HalfInning: "top", IsTopInning: true},
+					Runners: []mlb.PlayRunner{
+						{Movement: mlb.RunnerMovement{End: "score"}},
+					},
+				},
+			}},
+			Linescore: mlb.LiveLineScore{
+				Teams:   mlb.LineScoreTotals{Away: mlb.LineScoreTeam{Runs: 1, Hits: 1}},
+				Innings: []mlb.InningLine{{Num: 1, Away: mlb.InningTeamRuns{Runs: &one}}},
+			},
+		},
+	}
+	if mismatches := VerifyLineScore(feed); len(mismatches) != 0 {
+		t.Fatalf("expected no mismatches, got %v", mismatches)
+	}
+}
+
+func TestCompareLineScoresReportsDifferences(t *testing.T) {
+	zero, two := 0, 2
+	derived := mlb.LiveLineScore{
+		Teams:   mlb.LineScoreTotals{Home: mlb.LineScoreTeam{Errors: 1}},
+		Innings: []mlb.InningLine{{Num: 1, Away: mlb.InningTeamRuns{Runs: &zero}}},
+	}
+	official := mlb.LiveLineScore{
+		Teams:   mlb.LineScoreTotals{Home: mlb.LineScoreTeam{Errors: 2}},
+		Innings: []mlb.InningLine{{Num: 1, Away: mlb.InningTeamRuns{Runs: &two}}},
+	}
+	mismatches := compareLineScores(derived, official, "AWY", "HME")
+	if len(mismatches) != 2 {
+		t.Fatalf("expected 2 mismatches, got %v", mismatches)
+	}
+	if got := mismatches[0].String(); got != "HME E: derived 1, official 2" {
+		t.Fatalf("unexpected mismatch description %q", got)
+	}
+	if !strings.HasPrefix(mismatches[1].Field, "AWY 1st") {
+		t.Fatalf("expected inning mismatch, got %q", mismatches[1].Field)
+	}
+}